@@ -0,0 +1,4 @@
+data "porkbun_dns_records" "example" {
+  domain = "example.com"
+  type   = "MX"
+}
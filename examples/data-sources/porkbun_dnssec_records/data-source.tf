@@ -0,0 +1,3 @@
+data "porkbun_dnssec_records" "example" {
+  domain = "example.com"
+}
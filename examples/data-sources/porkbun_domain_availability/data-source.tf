@@ -0,0 +1,10 @@
+data "porkbun_domain_availability" "example" {
+  domain = "example.com"
+
+  lifecycle {
+    postcondition {
+      condition     = self.available && self.price <= 20
+      error_message = "example.com is unavailable or costs more than $20."
+    }
+  }
+}
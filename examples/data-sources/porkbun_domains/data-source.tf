@@ -0,0 +1,9 @@
+data "porkbun_domains" "example" {}
+
+resource "porkbun_dns_record" "spf" {
+  for_each = { for d in data.porkbun_domains.example.domains : d.domain => d if d.status == "ACTIVE" }
+
+  domain  = each.key
+  type    = "TXT"
+  content = "v=spf1 -all"
+}
@@ -0,0 +1,5 @@
+data "porkbun_ping" "example" {}
+
+output "egress_ip" {
+  value = data.porkbun_ping.example.your_ip
+}
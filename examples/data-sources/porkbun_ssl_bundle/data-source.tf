@@ -0,0 +1,7 @@
+data "porkbun_ssl_bundle" "example" {
+  domain = "example.com"
+}
+
+output "certificate_expires_at" {
+  value = data.porkbun_ssl_bundle.example.not_after
+}
@@ -0,0 +1,3 @@
+data "porkbun_tld_pricing" "example" {
+  tlds = ["com", "dev"]
+}
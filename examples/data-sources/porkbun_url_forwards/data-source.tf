@@ -0,0 +1,3 @@
+data "porkbun_url_forwards" "example" {
+  domain = "example.com"
+}
@@ -1 +1,5 @@
+# Import by record ID.
 terraform import porkbun_dns_record.example example.com/1234
+
+# Import by name and type, leave the name empty for records of the root domain.
+terraform import porkbun_dns_record.example example.com/www/CNAME
@@ -0,0 +1,2 @@
+# Leave the name empty for records of the root domain.
+terraform import porkbun_dns_record_set.example example.com//MX
@@ -0,0 +1,15 @@
+resource "porkbun_dns_record_set" "example" {
+  domain = "example.com"
+  type   = "MX"
+
+  records = [
+    {
+      content  = "mx1.example.net"
+      priority = 10
+    },
+    {
+      content  = "mx2.example.net"
+      priority = 20
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import porkbun_dns_zone.example example.com
@@ -0,0 +1,21 @@
+resource "porkbun_dns_zone" "example" {
+  domain                   = "example.com"
+  preserve_default_records = true
+
+  records = [
+    {
+      type    = "A"
+      content = "1.2.3.4"
+    },
+    {
+      name    = "www"
+      type    = "CNAME"
+      content = "example.com"
+    },
+    {
+      type     = "MX"
+      content  = "mx.example.net"
+      priority = 10
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import porkbun_dnssec_record.example example.com/64087
@@ -0,0 +1 @@
+terraform import porkbun_domain_auto_renew.example example.com
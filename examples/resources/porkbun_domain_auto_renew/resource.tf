@@ -0,0 +1,4 @@
+resource "porkbun_domain_auto_renew" "example" {
+  domain  = "example.com"
+  enabled = true
+}
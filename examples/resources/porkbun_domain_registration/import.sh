@@ -0,0 +1 @@
+terraform import porkbun_domain_registration.example example.com
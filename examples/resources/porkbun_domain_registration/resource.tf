@@ -0,0 +1,4 @@
+resource "porkbun_domain_registration" "example" {
+  domain    = "example.com"
+  max_price = 12.5
+}
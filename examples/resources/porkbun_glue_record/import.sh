@@ -0,0 +1 @@
+terraform import porkbun_glue_record.example example.com/ns1
@@ -0,0 +1,8 @@
+resource "porkbun_glue_record" "example" {
+  domain    = "example.com"
+  subdomain = "ns1"
+  ips = [
+    "192.0.2.1",
+    "2001:db8::1"
+  ]
+}
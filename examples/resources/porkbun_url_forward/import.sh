@@ -0,0 +1 @@
+terraform import porkbun_url_forward.example example.com/1234
@@ -0,0 +1,7 @@
+resource "porkbun_url_forward" "example" {
+  domain       = "example.com"
+  subdomain    = "blog"
+  location     = "https://medium.com/@example"
+  type         = "permanent"
+  include_path = true
+}
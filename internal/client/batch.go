@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// batchFlushWindow is how long a domain queue or list read waits for more concurrent operations on the same domain,
+// e.g. while Terraform creates or refreshes many records of the same domain in parallel.
+const batchFlushWindow = 10 * time.Millisecond
+
+// barrier is the key of changes that may touch any record of the domain, e.g. those addressing records by name and
+// type. They run once every change submitted before them is done, and before any change submitted after them.
+const barrier = "*"
+
+// domainQueues collects the DNS record changes of a domain into batches, and shares list requests between
+// concurrent reads. Changes of a batch run concurrently, except that changes of the same record run in the order
+// they were submitted. Reads don't wait for queued changes, so one slow change doesn't stall reading the domain.
+type domainQueues struct {
+	mu     sync.Mutex
+	queues map[string]*domainQueue
+	reads  map[string]*sharedRead
+	// generations counts the changes made to each domain, so that a read started before a change isn't shared
+	// with readers that came after it.
+	generations map[string]uint64
+}
+
+type domainQueue struct {
+	pending []*domainJob
+}
+
+// States of a domainJob.
+const (
+	jobPending int32 = iota
+	jobStarted
+	jobCancelled
+)
+
+type domainJob struct {
+	ctx context.Context
+	// key is the ID of the record the job changes, empty for creates and barrier for changes of any record.
+	key   string
+	call  func(ctx context.Context) error
+	state atomic.Int32
+	err   error
+	done  chan struct{}
+}
+
+type sharedRead struct {
+	generation uint64
+	records    []DNSRecord
+	err        error
+	done       chan struct{}
+}
+
+func newDomainQueues() *domainQueues {
+	return &domainQueues{
+		queues:      make(map[string]*domainQueue),
+		reads:       make(map[string]*sharedRead),
+		generations: make(map[string]uint64),
+	}
+}
+
+// mutate queues call to change the record with the given key, and invalidates what has been read of the domain so
+// far once it's done. If ctx is done before call starts, call is skipped altogether.
+func (c *Client) mutate(ctx context.Context, domain, key string, call func(ctx context.Context) error) error {
+	job := &domainJob{ctx: ctx, key: key, call: func(ctx context.Context) error {
+		defer c.invalidate(domain)
+		return call(ctx)
+	}, done: make(chan struct{})}
+
+	q := c.domainQueues
+	q.mu.Lock()
+	queue, running := q.queues[domain]
+	if !running {
+		queue = &domainQueue{}
+		q.queues[domain] = queue
+	}
+	queue.pending = append(queue.pending, job)
+	q.mu.Unlock()
+
+	if !running {
+		go c.work(domain, queue)
+	}
+
+	select {
+	case <-job.done:
+		return job.err
+	case <-ctx.Done():
+		if job.state.CompareAndSwap(jobPending, jobCancelled) {
+			return ctx.Err()
+		}
+		// The call already started, so report how it ended rather than pretend it didn't happen.
+		<-job.done
+		return job.err
+	}
+}
+
+func (c *Client) invalidate(domain string) {
+	q := c.domainQueues
+	q.mu.Lock()
+	q.generations[domain]++
+	q.mu.Unlock()
+
+	c.zoneCache.invalidate(domain)
+}
+
+// work runs the queued changes of a domain batch by batch and removes the queue once it's empty.
+func (c *Client) work(domain string, queue *domainQueue) {
+	q := c.domainQueues
+	for {
+		time.Sleep(batchFlushWindow)
+
+		q.mu.Lock()
+		jobs := queue.pending
+		queue.pending = nil
+		if len(jobs) == 0 {
+			delete(q.queues, domain)
+			q.mu.Unlock()
+			return
+		}
+		q.mu.Unlock()
+
+		runBatch(jobs)
+	}
+}
+
+// runBatch runs the jobs of a batch, one goroutine per record so that changes of the same record stay in order.
+func runBatch(jobs []*domainJob) {
+	var records [][]*domainJob
+	byKey := make(map[string]int)
+
+	flush := func() {
+		var wg sync.WaitGroup
+		for _, record := range records {
+			wg.Add(1)
+			go func(record []*domainJob) {
+				defer wg.Done()
+				for _, job := range record {
+					job.run()
+				}
+			}(record)
+		}
+		wg.Wait()
+
+		records = nil
+		clear(byKey)
+	}
+
+	for _, job := range jobs {
+		switch i, ok := byKey[job.key]; {
+		case job.key == barrier:
+			flush()
+			job.run()
+		case ok:
+			records[i] = append(records[i], job)
+		default:
+			if job.key != "" {
+				byKey[job.key] = len(records)
+			}
+			records = append(records, []*domainJob{job})
+		}
+	}
+	flush()
+}
+
+func (j *domainJob) run() {
+	defer close(j.done)
+
+	// Skip the call if its caller gave up, even if the caller hasn't noticed yet.
+	if j.ctx.Err() != nil || !j.state.CompareAndSwap(jobPending, jobStarted) {
+		j.err = j.ctx.Err()
+		return
+	}
+
+	j.err = j.call(j.ctx)
+}
+
+// listRecords returns all records of the domain, sharing the request with any other concurrent reads.
+func (c *Client) listRecords(ctx context.Context, domain string) ([]DNSRecord, error) {
+	if records, ok := c.zoneCache.get(domain); ok {
+		return records, nil
+	}
+
+	q := c.domainQueues
+	q.mu.Lock()
+	read, ok := q.reads[domain]
+	if !ok || read.generation != q.generations[domain] {
+		read = &sharedRead{generation: q.generations[domain], done: make(chan struct{})}
+		q.reads[domain] = read
+		// The request mustn't fail because the first reader gave up.
+		go c.read(context.WithoutCancel(ctx), domain, read)
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-read.done:
+		// Every reader gets its own copy to modify.
+		return append([]DNSRecord(nil), read.records...), read.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *Client) read(ctx context.Context, domain string, read *sharedRead) {
+	time.Sleep(batchFlushWindow)
+	read.records, read.err = c.retrieveDNSRecords(ctx, domain)
+
+	q := c.domainQueues
+	q.mu.Lock()
+	if q.reads[domain] == read {
+		delete(q.reads, domain)
+	}
+	if read.err == nil && read.generation == q.generations[domain] {
+		c.zoneCache.set(domain, read.records)
+	}
+	q.mu.Unlock()
+
+	close(read.done)
+}
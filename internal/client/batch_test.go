@@ -0,0 +1,85 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestQueuedDNSRecordChanges(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var requests []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		var record porkbun.DNSRecord
+		_ = json.NewDecoder(req.Body).Decode(&record)
+
+		mu.Lock()
+		requests = append(requests, req.URL.Path+" "+record.Content)
+		first := len(requests) == 1
+		mu.Unlock()
+
+		// Hold the first change back so that the others queue up behind it.
+		if first {
+			<-release
+		}
+		_, _ = rw.Write([]byte(`{"status": "SUCCESS"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	client := porkbun.New("apikey", "secretapikey")
+	baseURL, _ := url.Parse(server.URL)
+	client.SetCustomBaseURL(baseURL)
+
+	ctx := context.Background()
+	errs := make(chan error, 3)
+	edit := func(content string) {
+		errs <- client.EditDNSRecord(ctx, "example.com", "1", porkbun.DNSRecord{Content: content})
+	}
+
+	go edit("1.1.1.1")
+	time.Sleep(50 * time.Millisecond)
+	go edit("2.2.2.2")
+	time.Sleep(5 * time.Millisecond)
+	go edit("3.3.3.3")
+	time.Sleep(5 * time.Millisecond)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	deleted := make(chan error, 1)
+	go func() { deleted <- client.DeleteDNSRecord(cancelCtx, "example.com", "2") }()
+	time.Sleep(5 * time.Millisecond)
+	cancel()
+
+	if err := <-deleted; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancelled delete to fail with context.Canceled, got %v", err)
+	}
+
+	close(release)
+	for range 3 {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Test that changes of the same record are sent in order, and that the cancelled delete is never sent.
+	expected := []string{"/dns/edit/example.com/1 1.1.1.1", "/dns/edit/example.com/1 2.2.2.2", "/dns/edit/example.com/1 3.3.3.3"}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %q, got %q", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Fatalf("expected requests %q, got %q", expected, requests)
+		}
+	}
+}
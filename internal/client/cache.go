@@ -0,0 +1,47 @@
+package client
+
+import "sync"
+
+// zoneCache holds all records of each domain listed so far, until a record of the domain is changed.
+type zoneCache struct {
+	mu      sync.Mutex
+	records map[string][]DNSRecord
+}
+
+func newZoneCache() *zoneCache {
+	return &zoneCache{records: make(map[string][]DNSRecord)}
+}
+
+func (z *zoneCache) get(domain string) ([]DNSRecord, bool) {
+	if z == nil {
+		return nil, false
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	records, ok := z.records[domain]
+	return append([]DNSRecord(nil), records...), ok
+}
+
+func (z *zoneCache) set(domain string, records []DNSRecord) {
+	if z == nil {
+		return
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	z.records[domain] = append([]DNSRecord(nil), records...)
+}
+
+func (z *zoneCache) invalidate(domain string) {
+	if z == nil {
+		return
+	}
+
+	z.mu.Lock()
+	defer z.mu.Unlock()
+
+	delete(z.records, domain)
+}
@@ -8,6 +8,9 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -20,6 +23,21 @@ type Client struct {
 	apiKeys    *apiKeys
 	baseURL    *url.URL
 	httpClient *http.Client
+
+	domainQueues *domainQueues
+	// zoneCache is nil unless enabled with EnableZoneReadCache.
+	zoneCache *zoneCache
+	// allowedDomains is nil unless restricted with SetAllowedDomains.
+	allowedDomains map[string]struct{}
+	// userAgent is sent as the User-Agent header unless empty.
+	userAgent string
+	// domainNames caches the result of DomainNames.
+	domainNames *domainNamesCache
+}
+
+type domainNamesCache struct {
+	mu    sync.Mutex
+	names []string
 }
 
 func New(APIKey, SecretAPIKey string) Client {
@@ -36,6 +54,8 @@ func New(APIKey, SecretAPIKey string) Client {
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
+		domainQueues: newDomainQueues(),
+		domainNames:  &domainNamesCache{},
 	}
 }
 
@@ -47,12 +67,93 @@ func (c *Client) SetCustomHTTPClient(customHTTPClient *http.Client) {
 	c.httpClient = customHTTPClient
 }
 
+func (c *Client) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// EnableZoneReadCache makes the client list the records of each domain only once, answering later reads from the
+// cached listing until a record of the domain is created, edited or deleted through the client.
+func (c *Client) EnableZoneReadCache() {
+	c.zoneCache = newZoneCache()
+}
+
+// SetAllowedDomains restricts the domains the client is meant to manage, e.g. those of the account its API keys belong
+// to. An empty list allows any domain.
+func (c *Client) SetAllowedDomains(domains []string) {
+	if len(domains) == 0 {
+		c.allowedDomains = nil
+		return
+	}
+
+	c.allowedDomains = make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		c.allowedDomains[strings.ToLower(domain)] = struct{}{}
+	}
+}
+
+// AllowsDomain reports whether domain is one of the allowed domains, always true if they're not restricted.
+func (c *Client) AllowsDomain(domain string) bool {
+	if c.allowedDomains == nil {
+		return true
+	}
+
+	_, ok := c.allowedDomains[strings.ToLower(domain)]
+	return ok
+}
+
+// AllowedDomains returns the sorted allowed domains, nil if they're not restricted.
+func (c *Client) AllowedDomains() []string {
+	if c.allowedDomains == nil {
+		return nil
+	}
+
+	domains := make([]string, 0, len(c.allowedDomains))
+	for domain := range c.allowedDomains {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	return domains
+}
+
+type noRetriesKey struct{}
+
+// withoutRetries marks requests made with ctx as unsafe to repeat.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetriesKey{}, true)
+}
+
+// RetriesAllowed reports whether a request made with ctx may be retried. Retrying HTTP clients set with
+// SetCustomHTTPClient have to check it, e.g. in their retry policy.
+func RetriesAllowed(ctx context.Context) bool {
+	noRetries, _ := ctx.Value(noRetriesKey{}).(bool)
+	return !noRetries
+}
+
 func (c *Client) do(ctx context.Context, url *url.URL, requestBody, responseBuffer interface{}) error {
 	bodyMarshaled, err := marshalAndJoin(c.apiKeys, requestBody)
 	if err != nil {
 		return fmt.Errorf("marshaling request body failed: %w", err)
 	}
 
+	return c.send(ctx, url, bodyMarshaled, responseBuffer)
+}
+
+// doUnauthenticated is like do but without API keys in the request body, for endpoints that don't require them.
+func (c *Client) doUnauthenticated(ctx context.Context, url *url.URL, requestBody, responseBuffer interface{}) error {
+	bodyMarshaled := []byte("{}")
+	if requestBody != nil {
+		var err error
+		bodyMarshaled, err = json.Marshal(requestBody)
+		if err != nil {
+			return fmt.Errorf("marshaling request body failed: %w", err)
+		}
+	}
+
+	return c.send(ctx, url, bodyMarshaled, responseBuffer)
+}
+
+func (c *Client) send(ctx context.Context, url *url.URL, bodyMarshaled []byte, responseBuffer interface{}) error {
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodPost,
@@ -62,6 +163,9 @@ func (c *Client) do(ctx context.Context, url *url.URL, requestBody, responseBuff
 	if err != nil {
 		return fmt.Errorf("creating request object failed: %w", err)
 	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -74,6 +178,17 @@ func (c *Client) do(ctx context.Context, url *url.URL, requestBody, responseBuff
 		return fmt.Errorf("reading response body failed: %w", err)
 	}
 
+	// Every Porkbun response carries a status, failures may come with any HTTP status code.
+	var responseStatus status
+	err = json.Unmarshal(responseBody, &responseStatus)
+	if err != nil && resp.StatusCode < http.StatusBadRequest {
+		return fmt.Errorf("unmarshaling response body failed: %w", err)
+	}
+
+	if err != nil || responseStatus.failed() {
+		return newAPIError(resp.StatusCode, c.endpoint(url), responseStatus)
+	}
+
 	err = json.Unmarshal(responseBody, responseBuffer)
 	if err != nil {
 		return fmt.Errorf("unmarshaling response body failed: %w", err)
@@ -81,3 +196,8 @@ func (c *Client) do(ctx context.Context, url *url.URL, requestBody, responseBuff
 
 	return err
 }
+
+// endpoint returns the path of url relative to the base URL, for error messages.
+func (c *Client) endpoint(url *url.URL) string {
+	return "/" + strings.TrimPrefix(strings.TrimPrefix(url.Path, c.baseURL.Path), "/")
+}
@@ -2,7 +2,8 @@ package client
 
 import (
 	"context"
-	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // All the fields are `omitempty` so that the same struct can be used both as input type for creating records
@@ -27,16 +28,14 @@ func (c *Client) CreateDNSRecord(ctx context.Context, domain string, record DNSR
 	url := c.baseURL.JoinPath("dns", "create", domain)
 
 	var response createDNSRecordResponse
-	err := c.do(ctx, url, record, &response)
+	err := c.mutate(ctx, domain, "", func(ctx context.Context) error {
+		return c.do(ctx, url, record, &response)
+	})
 
 	if err != nil {
 		return 0, err
 	}
 
-	if response.failed() {
-		return 0, response.status
-	}
-
 	return response.ID, nil
 }
 
@@ -45,56 +44,126 @@ type retrieveDNSRecordResponse struct {
 	Records []DNSRecord `json:"records"`
 }
 
+// RetrieveDNSRecord picks the record out of all records of the domain, so that concurrent reads of records of the
+// same domain cost a single request.
 func (c *Client) RetrieveDNSRecord(ctx context.Context, domain, id string) (DNSRecord, error) {
-	url := c.baseURL.JoinPath("dns", "retrieve", domain, id)
-
-	var response retrieveDNSRecordResponse
-	err := c.do(ctx, url, nil, &response)
-
+	records, err := c.listRecords(ctx, domain)
 	if err != nil {
 		return DNSRecord{}, err
 	}
 
-	if response.failed() {
-		return DNSRecord{}, response.status
+	for _, record := range records {
+		if record.ID == id {
+			return record, nil
+		}
 	}
 
-	if len(response.Records) < 1 {
-		return DNSRecord{}, fmt.Errorf("DNS record not found")
+	return DNSRecord{}, &APIError{
+		StatusCode: http.StatusOK,
+		Endpoint:   c.endpoint(c.baseURL.JoinPath("dns", "retrieve", domain, id)),
+		Status:     "SUCCESS",
+		Message:    "DNS record not found",
+		kind:       ErrNotFound,
+	}
+}
+
+func (c *Client) RetrieveDNSRecords(ctx context.Context, domain string) ([]DNSRecord, error) {
+	return c.listRecords(ctx, domain)
+}
+
+func (c *Client) retrieveDNSRecords(ctx context.Context, domain string) ([]DNSRecord, error) {
+	url := c.baseURL.JoinPath("dns", "retrieve", domain)
+
+	var response retrieveDNSRecordResponse
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return response.Records[0], nil
+	return response.Records, nil
 }
 
 func (c *Client) EditDNSRecord(ctx context.Context, domain, id string, record DNSRecord) error {
 	url := c.baseURL.JoinPath("dns", "edit", domain, id)
 
-	response := status{}
-	err := c.do(ctx, url, record, &response)
+	err := c.mutate(ctx, domain, id, func(ctx context.Context) error {
+		return c.do(ctx, url, record, &status{})
+	})
 
 	if err != nil {
 		return err
 	}
 
-	if response.failed() {
-		return response
-	}
-
 	return nil
 }
 
 func (c *Client) DeleteDNSRecord(ctx context.Context, domain, id string) error {
 	url := c.baseURL.JoinPath("dns", "delete", domain, id)
 
-	response := status{}
+	err := c.mutate(ctx, domain, id, func(ctx context.Context) error {
+		return c.do(ctx, url, nil, &status{})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Porkbun addresses records by name and type with the subdomain as the last path segment, which is omitted
+// entirely for records of the root domain.
+func (c *Client) joinNameTypePath(action, domain, recordType, subdomain string) *url.URL {
+	url := c.baseURL.JoinPath("dns", action, domain, recordType)
+	if subdomain != "" {
+		url = url.JoinPath(subdomain)
+	}
+
+	return url
+}
+
+func (c *Client) RetrieveDNSRecordsByNameType(ctx context.Context, domain, recordType, subdomain string) ([]DNSRecord, error) {
+	url := c.joinNameTypePath("retrieveByNameType", domain, recordType, subdomain)
+
+	var response retrieveDNSRecordResponse
 	err := c.do(ctx, url, nil, &response)
 
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Records, nil
+}
+
+// Name and type of the record can't be changed when editing by name and type, so they're dropped from the payload.
+func (c *Client) EditDNSRecordsByNameType(ctx context.Context, domain, recordType, subdomain string, record DNSRecord) error {
+	url := c.joinNameTypePath("editByNameType", domain, recordType, subdomain)
+
+	record.ID = ""
+	record.Name = ""
+	record.Type = ""
+
+	err := c.mutate(ctx, domain, barrier, func(ctx context.Context) error {
+		return c.do(ctx, url, record, &status{})
+	})
+
 	if err != nil {
 		return err
 	}
 
-	if response.failed() {
-		return response
+	return nil
+}
+
+func (c *Client) DeleteDNSRecordsByNameType(ctx context.Context, domain, recordType, subdomain string) error {
+	url := c.joinNameTypePath("deleteByNameType", domain, recordType, subdomain)
+
+	err := c.mutate(ctx, domain, barrier, func(ctx context.Context) error {
+		return c.do(ctx, url, nil, &status{})
+	})
+
+	if err != nil {
+		return err
 	}
 
 	return nil
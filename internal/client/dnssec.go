@@ -0,0 +1,100 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+)
+
+// DNSSECRecord is a DS record published at the registry. The key data fields are optional and only required by some
+// registries.
+type DNSSECRecord struct {
+	KeyTag           string `json:"keyTag"`
+	Algorithm        string `json:"alg"`
+	DigestType       string `json:"digestType"`
+	Digest           string `json:"digest"`
+	MaxSigLife       string `json:"maxSigLife,omitempty"`
+	KeyDataFlags     string `json:"keyDataFlags,omitempty"`
+	KeyDataProtocol  string `json:"keyDataProtocol,omitempty"`
+	KeyDataAlgorithm string `json:"keyDataAlgo,omitempty"`
+	KeyDataPublicKey string `json:"keyDataPubKey,omitempty"`
+}
+
+func (c *Client) CreateDNSSECRecord(ctx context.Context, domain string, record DNSSECRecord) error {
+	url := c.baseURL.JoinPath("dns", "createDnssecRecord", domain)
+
+	response := status{}
+	err := c.do(ctx, url, record, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type getDNSSECRecordsResponse struct {
+	status
+	Records dnssecRecordsByKeyTag `json:"records"`
+}
+
+// dnssecRecordsByKeyTag holds the records as Porkbun returns them, an object keyed by their key tags. Being PHP
+// behind the scenes, Porkbun returns an empty array instead of an empty object, so arrays are accepted too.
+type dnssecRecordsByKeyTag map[string]DNSSECRecord
+
+func (r *dnssecRecordsByKeyTag) UnmarshalJSON(data []byte) error {
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		return json.Unmarshal(data, (*map[string]DNSSECRecord)(r))
+	}
+
+	var records []DNSSECRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return err
+	}
+
+	*r = make(dnssecRecordsByKeyTag, len(records))
+	for _, record := range records {
+		(*r)[record.KeyTag] = record
+	}
+
+	return nil
+}
+
+func (c *Client) GetDNSSECRecords(ctx context.Context, domain string) ([]DNSSECRecord, error) {
+	url := c.baseURL.JoinPath("dns", "getDnssecRecords", domain)
+
+	var response getDNSSECRecordsResponse
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]DNSSECRecord, 0, len(response.Records))
+	for _, record := range response.Records {
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		a, _ := strconv.Atoi(records[i].KeyTag)
+		b, _ := strconv.Atoi(records[j].KeyTag)
+		return a < b
+	})
+
+	return records, nil
+}
+
+func (c *Client) DeleteDNSSECRecord(ctx context.Context, domain, keyTag string) error {
+	url := c.baseURL.JoinPath("dns", "deleteDnssecRecord", domain, keyTag)
+
+	response := status{}
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package client_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/mockbun"
+)
+
+func TestGetDNSSECRecords(t *testing.T) {
+	server := mockbun.New()
+	t.Cleanup(server.Close)
+	server.SetDNSSECRecords("example.com", []porkbun.DNSSECRecord{
+		{KeyTag: "64087", Algorithm: "13", DigestType: "2", Digest: "15E445BD"},
+		{KeyTag: "2371", Algorithm: "8", DigestType: "2", Digest: "1F987CC6"},
+	})
+	server.SetDNSSECRecords("example.org", nil)
+
+	client := porkbun.New("apikey", "secretapikey")
+	baseURL, _ := url.Parse(server.URL)
+	client.SetCustomBaseURL(baseURL)
+
+	records, err := client.GetDNSSECRecords(context.Background(), "example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].KeyTag != "2371" || records[1].KeyTag != "64087" {
+		t.Errorf("expected records 2371 and 64087, got %+v", records)
+	}
+
+	// Test that the empty array Porkbun returns for a domain without DS records reads as no records.
+	records, err = client.GetDNSSECRecords(context.Background(), "example.org")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 0 {
+		t.Errorf("expected no records, got %+v", records)
+	}
+}
@@ -0,0 +1,257 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// FlexibleBool unmarshals the booleans Porkbun returns inconsistently as `1`, `"1"`, `"yes"` or `true`.
+type FlexibleBool bool
+
+func (b *FlexibleBool) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case bool:
+		*b = FlexibleBool(v)
+	case float64:
+		*b = v != 0
+	case string:
+		parsed, _ := strconv.ParseBool(v)
+		*b = FlexibleBool(parsed || v == "yes")
+	default:
+		*b = false
+	}
+
+	return nil
+}
+
+// FlexibleString unmarshals identifiers Porkbun returns inconsistently as either numbers or strings.
+type FlexibleString string
+
+func (s *FlexibleString) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case string:
+		*s = FlexibleString(v)
+	case float64:
+		*s = FlexibleString(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		*s = ""
+	}
+
+	return nil
+}
+
+type DomainLabel struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Color string `json:"color"`
+}
+
+type Domain struct {
+	Domain       string        `json:"domain"`
+	Status       string        `json:"status"`
+	TLD          string        `json:"tld"`
+	CreateDate   string        `json:"createDate"`
+	ExpireDate   string        `json:"expireDate"`
+	SecurityLock FlexibleBool  `json:"securityLock"`
+	WhoisPrivacy FlexibleBool  `json:"whoisPrivacy"`
+	AutoRenew    FlexibleBool  `json:"autoRenew"`
+	NotLocal     FlexibleBool  `json:"notLocal"`
+	Labels       []DomainLabel `json:"labels,omitempty"`
+}
+
+// Porkbun returns at most this many domains per request, the rest have to be paginated through with `start`.
+const listDomainsPageSize = 1000
+
+type listDomainsPayload struct {
+	Start         string `json:"start"`
+	IncludeLabels string `json:"includeLabels"`
+}
+
+type listDomainsResponse struct {
+	status
+	Domains []Domain `json:"domains"`
+}
+
+func (c *Client) ListDomains(ctx context.Context) ([]Domain, error) {
+	url := c.baseURL.JoinPath("domain", "listAll")
+
+	var domains []Domain
+	for start := 0; ; start += listDomainsPageSize {
+		var response listDomainsResponse
+		err := c.do(ctx, url, listDomainsPayload{strconv.Itoa(start), "yes"}, &response)
+
+		if err != nil {
+			return nil, err
+		}
+
+		domains = append(domains, response.Domains...)
+		if len(response.Domains) < listDomainsPageSize {
+			break
+		}
+	}
+
+	return domains, nil
+}
+
+// DomainNames returns the names of all domains in the account. The list is fetched once per client, later calls
+// reuse it.
+func (c *Client) DomainNames(ctx context.Context) ([]string, error) {
+	c.domainNames.mu.Lock()
+	defer c.domainNames.mu.Unlock()
+
+	if c.domainNames.names == nil {
+		domains, err := c.ListDomains(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		names := make([]string, len(domains))
+		for i, domain := range domains {
+			names[i] = domain.Domain
+		}
+		c.domainNames.names = names
+	}
+
+	return c.domainNames.names, nil
+}
+
+// GetDomain looks the domain up in the domain list, as Porkbun has no endpoint to get a single domain.
+func (c *Client) GetDomain(ctx context.Context, domain string) (Domain, error) {
+	domains, err := c.ListDomains(ctx)
+	if err != nil {
+		return Domain{}, err
+	}
+
+	for _, d := range domains {
+		if strings.EqualFold(d.Domain, domain) {
+			return d, nil
+		}
+	}
+
+	return Domain{}, &APIError{
+		StatusCode: http.StatusOK,
+		Endpoint:   c.endpoint(c.baseURL.JoinPath("domain", "listAll")),
+		Status:     "SUCCESS",
+		Message:    fmt.Sprintf("Domain %s not found in account", domain),
+		kind:       ErrNotFound,
+	}
+}
+
+type updateAutoRenewPayload struct {
+	Status  string   `json:"status"`
+	Domains []string `json:"domains,omitempty"`
+}
+
+type updateAutoRenewResponse struct {
+	status
+	Results map[string]status `json:"results"`
+}
+
+// UpdateAutoRenew turns auto-renew on or off for the given domains, using Porkbun's bulk endpoint when there are
+// several of them.
+func (c *Client) UpdateAutoRenew(ctx context.Context, autoRenew bool, domains ...string) error {
+	payload := updateAutoRenewPayload{Status: "off"}
+	if autoRenew {
+		payload.Status = "on"
+	}
+
+	url := c.baseURL.JoinPath("domain", "updateAutoRenew")
+	if len(domains) == 1 {
+		url = url.JoinPath(domains[0])
+	} else {
+		payload.Domains = domains
+	}
+
+	var response updateAutoRenewResponse
+	err := c.do(ctx, url, payload, &response)
+
+	if err != nil {
+		return err
+	}
+
+	for domain, result := range response.Results {
+		if result.failed() {
+			return fmt.Errorf("%s: %w", domain, newAPIError(http.StatusOK, c.endpoint(url), result))
+		}
+	}
+
+	return nil
+}
+
+type DomainPrice struct {
+	Type  string `json:"type"`
+	Price string `json:"price"`
+}
+
+// Prices are returned as decimal strings in USD.
+type DomainAvailability struct {
+	Available      FlexibleBool `json:"avail"`
+	Type           string       `json:"type"`
+	Price          string       `json:"price"`
+	FirstYearPromo FlexibleBool `json:"firstYearPromo"`
+	RegularPrice   string       `json:"regularPrice"`
+	Premium        FlexibleBool `json:"premium"`
+	Additional     struct {
+		Renewal  DomainPrice `json:"renewal"`
+		Transfer DomainPrice `json:"transfer"`
+	} `json:"additional"`
+}
+
+type checkDomainResponse struct {
+	status
+	Response DomainAvailability `json:"response"`
+}
+
+func (c *Client) CheckDomain(ctx context.Context, domain string) (DomainAvailability, error) {
+	url := c.baseURL.JoinPath("domain", "checkDomain", domain)
+
+	var response checkDomainResponse
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return DomainAvailability{}, err
+	}
+
+	return response.Response, nil
+}
+
+type createDomainPayload struct {
+	// Cost is in pennies and has to match the price Porkbun quotes for the domain.
+	Cost         int    `json:"cost"`
+	AgreeToTerms string `json:"agreeToTerms"`
+}
+
+type createDomainResponse struct {
+	status
+	OrderID FlexibleString `json:"orderId"`
+}
+
+// CreateDomain registers the domain for the given cost in pennies, agreeing to Porkbun's terms of service.
+// It returns the ID of the order. The request is never retried, as a retry could register and charge twice.
+func (c *Client) CreateDomain(ctx context.Context, domain string, cost int) (string, error) {
+	url := c.baseURL.JoinPath("domain", "create", domain)
+	ctx = withoutRetries(ctx)
+
+	var response createDomainResponse
+	err := c.do(ctx, url, createDomainPayload{cost, "yes"}, &response)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(response.OrderID), nil
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Kinds of API errors, to be matched with errors.Is.
+var (
+	ErrNotFound          = errors.New("not found")
+	ErrAuth              = errors.New("authentication failed")
+	ErrAPIAccessDisabled = errors.New("API access disabled")
+	ErrRateLimited       = errors.New("rate limited")
+	ErrValidation        = errors.New("validation failed")
+	ErrDuplicate         = errors.New("duplicate")
+)
+
+// APIError is returned for every request Porkbun did not answer with a SUCCESS status.
+type APIError struct {
+	// HTTP status code of the response.
+	StatusCode int
+	// Endpoint is the path of the request relative to the base URL, e.g. /dns/retrieve/example.com.
+	Endpoint string
+	// Status and Message as returned by Porkbun.
+	Status  string
+	Message string
+
+	kind error
+}
+
+func newAPIError(statusCode int, endpoint string, s status) *APIError {
+	return &APIError{
+		StatusCode: statusCode,
+		Endpoint:   endpoint,
+		Status:     s.StatusValue,
+		Message:    s.Message,
+		kind:       classifyError(statusCode, endpoint, s.Message),
+	}
+}
+
+func (e *APIError) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+
+	return fmt.Sprintf("%s returned HTTP %d: %s", e.Endpoint, e.StatusCode, message)
+}
+
+// Unwrap returns one of the ErrX kinds above, or nil if the error doesn't fit any of them.
+func (e *APIError) Unwrap() error {
+	return e.kind
+}
+
+// IsRateLimitResponse reports whether a raw response means Porkbun is throttling requests, either through the HTTP
+// status code or through the message of a FAILURE status.
+func IsRateLimitResponse(statusCode int, body []byte) bool {
+	var s status
+	_ = json.Unmarshal(body, &s)
+	if statusCode < http.StatusBadRequest && !s.failed() {
+		return false
+	}
+
+	return classifyError(statusCode, "", s.Message) == ErrRateLimited
+}
+
+// createEndpoints are the endpoints that create objects, the only ones whose failures can mean a duplicate.
+var createEndpoints = []string{"/dns/create/", "/dns/createDnssecRecord/", "/domain/addUrlForward/", "/domain/createGlue/"}
+
+// classifyError guesses the kind of an error. Porkbun answers most failures with HTTP 400, so the message is
+// usually more telling than the status code.
+func classifyError(statusCode int, endpoint, message string) error {
+	message = strings.ToLower(message)
+
+	switch {
+	case strings.Contains(message, "api access"):
+		return ErrAPIAccessDisabled
+	case statusCode == http.StatusUnauthorized, statusCode == http.StatusForbidden,
+		strings.Contains(message, "invalid api key"), strings.Contains(message, "must have api key"):
+		return ErrAuth
+	case statusCode == http.StatusTooManyRequests,
+		strings.Contains(message, "rate limit"), strings.Contains(message, "too many"):
+		return ErrRateLimited
+	case statusCode == http.StatusNotFound,
+		strings.Contains(message, "not found"), strings.Contains(message, "invalid record id"),
+		strings.Contains(message, "invalid domain"):
+		return ErrNotFound
+	case isCreateEndpoint(endpoint) &&
+		(strings.Contains(message, "duplicate") || strings.Contains(message, "already exists")):
+		return ErrDuplicate
+	case statusCode < http.StatusInternalServerError:
+		return ErrValidation
+	}
+
+	return nil
+}
+
+func isCreateEndpoint(endpoint string) bool {
+	for _, prefix := range createEndpoints {
+		if strings.HasPrefix(endpoint, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type GlueRecord struct {
+	Host string
+	IPv4 []string
+	IPv6 []string
+}
+
+// Porkbun returns each glue record as a tuple of the host and its addresses, e.g.
+// `["ns1.example.com", {"v4": ["1.2.3.4"], "v6": []}]`.
+func (g *GlueRecord) UnmarshalJSON(data []byte) error {
+	var addresses struct {
+		V4 []string `json:"v4"`
+		V6 []string `json:"v6"`
+	}
+
+	tuple := []interface{}{&g.Host, &addresses}
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+
+	g.IPv4 = addresses.V4
+	g.IPv6 = addresses.V6
+	return nil
+}
+
+type glueRecordPayload struct {
+	IPs []string `json:"ips"`
+}
+
+func (c *Client) CreateGlueRecord(ctx context.Context, domain, subdomain string, ips []string) error {
+	url := c.baseURL.JoinPath("domain", "createGlue", domain, subdomain)
+
+	response := status{}
+	err := c.do(ctx, url, glueRecordPayload{ips}, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateGlueRecord(ctx context.Context, domain, subdomain string, ips []string) error {
+	url := c.baseURL.JoinPath("domain", "updateGlue", domain, subdomain)
+
+	response := status{}
+	err := c.do(ctx, url, glueRecordPayload{ips}, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteGlueRecord(ctx context.Context, domain, subdomain string) error {
+	url := c.baseURL.JoinPath("domain", "deleteGlue", domain, subdomain)
+
+	response := status{}
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type getGlueRecordsResponse struct {
+	status
+	Hosts []GlueRecord `json:"hosts"`
+}
+
+func (c *Client) GetGlueRecords(ctx context.Context, domain string) ([]GlueRecord, error) {
+	url := c.baseURL.JoinPath("domain", "getGlue", domain)
+
+	var response getGlueRecordsResponse
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Hosts, nil
+}
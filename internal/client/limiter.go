@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// limiter combines a token bucket, refilled at a steady rate, with a semaphore capping requests in flight.
+type limiter struct {
+	mu       sync.Mutex
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	inFlight chan struct{}
+}
+
+// newLimiter returns a limiter allowing requestsPerSecond requests per second with at most maxConcurrent in flight.
+// Either limit is disabled when not positive.
+func newLimiter(requestsPerSecond float64, maxConcurrent int) *limiter {
+	l := &limiter{
+		rate:  requestsPerSecond,
+		burst: max(requestsPerSecond, 1),
+		last:  time.Now(),
+	}
+	l.tokens = l.burst
+
+	if maxConcurrent > 0 {
+		l.inFlight = make(chan struct{}, maxConcurrent)
+	}
+
+	return l
+}
+
+// acquire blocks until a request may be sent. Every successful acquire must be followed by a release.
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		l.release()
+		return err
+	}
+
+	return nil
+}
+
+func (l *limiter) release() {
+	if l.inFlight != nil {
+		<-l.inFlight
+	}
+}
+
+// wait takes a token from the bucket, sleeping until one is available.
+func (l *limiter) wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	// Reserve the token right away, going into debt if needed, so that waiters are served in order.
+	l.tokens--
+	delay := time.Duration(0)
+	if l.tokens < 0 {
+		delay = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give back the reserved token.
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// NewRateLimitedTransport returns a transport sending requests through next at most requestsPerSecond per second,
+// with at most maxConcurrentRequests in flight at once. A limit that's not positive is disabled. Every attempt of a
+// retried request is limited on its own, so the transport has to sit below any retrying client.
+func NewRateLimitedTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrentRequests int) http.RoundTripper {
+	return &limitedTransport{
+		limiter: newLimiter(requestsPerSecond, maxConcurrentRequests),
+		next:    next,
+	}
+}
+
+type limitedTransport struct {
+	limiter *limiter
+	next    http.RoundTripper
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.acquire(req.Context()); err != nil {
+		return nil, fmt.Errorf("waiting for rate limiter failed: %w", err)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.limiter.release()
+		return nil, err
+	}
+
+	// The request stays in flight until its response is read.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.limiter.release}
+	return resp, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
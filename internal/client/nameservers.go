@@ -17,10 +17,6 @@ func (c *Client) GetNameservers(ctx context.Context, domain string) ([]string, e
 		return nil, err
 	}
 
-	if response.failed() {
-		return nil, response.status
-	}
-
 	return response.Ns, nil
 }
 
@@ -38,9 +34,5 @@ func (c *Client) UpdateNameservers(ctx context.Context, domain string, Ns []stri
 		return err
 	}
 
-	if response.failed() {
-		return response
-	}
-
 	return nil
 }
@@ -16,9 +16,5 @@ func (c *Client) Ping(ctx context.Context) (string, error) {
 		return "", err
 	}
 
-	if response.status.failed() {
-		return "", response.status
-	}
-
 	return response.YourIp, nil
 }
@@ -0,0 +1,29 @@
+package client
+
+import "context"
+
+// Prices are returned as decimal strings in USD.
+type TLDPricing struct {
+	Registration string `json:"registration"`
+	Renewal      string `json:"renewal"`
+	Transfer     string `json:"transfer"`
+}
+
+type getPricingResponse struct {
+	status
+	Pricing map[string]TLDPricing `json:"pricing"`
+}
+
+// GetPricing returns the default pricing of all supported TLDs keyed by TLD. It doesn't require API keys.
+func (c *Client) GetPricing(ctx context.Context) (map[string]TLDPricing, error) {
+	url := c.baseURL.JoinPath("pricing", "get")
+
+	var response getPricingResponse
+	err := c.doUnauthenticated(ctx, url, nil, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Pricing, nil
+}
@@ -0,0 +1,27 @@
+package client
+
+import "context"
+
+type SSLBundle struct {
+	CertificateChain string `json:"certificatechain"`
+	PrivateKey       string `json:"privatekey"`
+	PublicKey        string `json:"publickey"`
+}
+
+type retrieveSSLBundleResponse struct {
+	status
+	SSLBundle
+}
+
+func (c *Client) RetrieveSSLBundle(ctx context.Context, domain string) (SSLBundle, error) {
+	url := c.baseURL.JoinPath("ssl", "retrieve", domain)
+
+	var response retrieveSSLBundleResponse
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return SSLBundle{}, err
+	}
+
+	return response.SSLBundle, nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import "context"
+
+// Porkbun represents the `includePath` and `wildcard` flags as "yes" or "no" strings.
+type URLForward struct {
+	ID          string `json:"id,omitempty"`
+	Subdomain   string `json:"subdomain"`
+	Location    string `json:"location"`
+	Type        string `json:"type"`
+	IncludePath string `json:"includePath"`
+	Wildcard    string `json:"wildcard"`
+}
+
+func (c *Client) AddURLForward(ctx context.Context, domain string, forward URLForward) error {
+	url := c.baseURL.JoinPath("domain", "addUrlForward", domain)
+
+	response := status{}
+	err := c.do(ctx, url, forward, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+type getURLForwardsResponse struct {
+	status
+	Forwards []URLForward `json:"forwards"`
+}
+
+func (c *Client) GetURLForwards(ctx context.Context, domain string) ([]URLForward, error) {
+	url := c.baseURL.JoinPath("domain", "getUrlForwarding", domain)
+
+	var response getURLForwardsResponse
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Forwards, nil
+}
+
+func (c *Client) DeleteURLForward(ctx context.Context, domain, id string) error {
+	url := c.baseURL.JoinPath("domain", "deleteUrlForward", domain, id)
+
+	response := status{}
+	err := c.do(ctx, url, nil, &response)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -32,7 +32,3 @@ type status struct {
 func (s *status) failed() bool {
 	return s.StatusValue != "SUCCESS"
 }
-
-func (s status) Error() string {
-	return fmt.Sprintf("%s: %s", s.StatusValue, s.Message)
-}
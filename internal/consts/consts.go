@@ -10,9 +10,24 @@ func GetDefaultNameservers() []string {
 	}
 }
 
+// DefaultParkingHost is the target of the ALIAS and CNAME records Porkbun creates for newly registered domains.
+const DefaultParkingHost = "pixie.porkbun.com"
+
 const (
 	ErrUnknownConfigurationValue         = "Unknown configuration value"
 	ErrInvalidConfigurationValue         = "Invalid configuration value"
 	ErrUnexpectedDataSourceConfigureType = "Unexpected data source configure type"
 	ErrUnexpectedResourceConfigureType   = "Unexpected resource configure type"
 )
+
+// Summaries of diagnostics for known Porkbun API failures.
+const (
+	ErrAPIAccessDisabled  = "API access disabled for domain"
+	ErrInvalidAPIKey      = "Invalid Porkbun API key"
+	ErrDomainNotInAccount = "Domain not in account"
+	ErrDuplicateDNSRecord = "Duplicate DNS record"
+	ErrAlreadyExists      = "Object already exists"
+)
+
+// ErrDomainNotAllowed is the summary of the diagnostic for domains outside the `domains` of the provider.
+const ErrDomainNotAllowed = "Domain not allowed by provider configuration"
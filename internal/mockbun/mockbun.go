@@ -4,51 +4,395 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"math/rand"
+	"math"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
+	"sync"
 
 	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
 )
 
 type Server struct {
+	// Resources are applied in parallel, mu serializes every request and setter.
+	mu sync.Mutex
+
 	mux         *http.ServeMux
 	server      *httptest.Server
 	URL         string
 	nameservers map[string][]string
 	dnsRecords  map[string][]porkbun.DNSRecord
+
+	urlForwards map[string][]porkbun.URLForward
+	dnssec      map[string][]porkbun.DNSSECRecord
+	glue        map[string]map[string][]string
+	sslBundles  map[string]porkbun.SSLBundle
+	domains     []porkbun.Domain
+	available   map[string]porkbun.DomainAvailability
+	pricing     map[string]porkbun.TLDPricing
+
+	// Number of upcoming requests answered with a rate-limit failure.
+	rateLimited int
+	// Message every request fails with, unless empty.
+	failure string
+	// Number of requests served so far, by route pattern.
+	requests map[string]int
+	// User-Agent header of the last request.
+	userAgent string
+
+	// Sequential instead of random IDs so that records created by a single test never collide.
+	lastDNSRecordID  int
+	lastURLForwardID int
 }
 
 func New() *Server {
 	mux := http.NewServeMux()
-	server := httptest.NewServer(mux)
 
 	m := &Server{
 		mux:         mux,
-		server:      server,
-		URL:         server.URL,
 		nameservers: make(map[string][]string),
 		dnsRecords:  make(map[string][]porkbun.DNSRecord),
+
+		urlForwards: make(map[string][]porkbun.URLForward),
+		dnssec:      make(map[string][]porkbun.DNSSECRecord),
+		glue:        make(map[string]map[string][]string),
+		sslBundles:  make(map[string]porkbun.SSLBundle),
+		available:   make(map[string]porkbun.DomainAvailability),
+		pricing:     make(map[string]porkbun.TLDPricing),
+		requests:    make(map[string]int),
+
+		lastDNSRecordID:  100000,
+		lastURLForwardID: 100000,
 	}
 
 	m.addPorkbunHandlers()
+	m.server = httptest.NewServer(http.HandlerFunc(m.serveHTTP))
+	m.URL = m.server.URL
 	return m
 }
 
+func (m *Server) serveHTTP(rw http.ResponseWriter, req *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, pattern := m.mux.Handler(req)
+	m.requests[pattern]++
+	m.userAgent = req.UserAgent()
+
+	if m.rateLimited > 0 {
+		m.rateLimited--
+		// Porkbun doesn't reliably use 429, the message is what gives rate limiting away.
+		rw.Header().Set("Content-Type", "application/json")
+		rw.Header().Set("Retry-After", "0")
+		rw.WriteHeader(http.StatusBadRequest)
+		_, _ = rw.Write([]byte(`{
+			"status": "FAILURE",
+			"message": "Rate limit exceeded, please slow down."
+		}`))
+		return
+	}
+
+	if m.failure != "" {
+		msg, _ := json.Marshal(m.failure)
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusBadRequest)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "FAILURE",
+			"message": %s
+		}`, msg)))
+		return
+	}
+
+	m.mux.ServeHTTP(rw, req)
+}
+
 func (m *Server) Close() {
 	m.server.Close()
 }
 
 func (m *Server) SetNameservers(domain string, nameservers []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
 	m.nameservers[domain] = nameservers
 }
 
+// RemoveDomain forgets the domain along with its nameservers and DNS records, as if it had left the account.
+func (m *Server) RemoveDomain(domain string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.nameservers, domain)
+	delete(m.dnsRecords, domain)
+	for i, d := range m.domains {
+		if d.Domain == domain {
+			m.domains = append(m.domains[:i], m.domains[i+1:]...)
+			break
+		}
+	}
+}
+
 func (m *Server) SetDNSRecords(domain string, records []porkbun.DNSRecord) {
-	m.dnsRecords[domain] = records
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.dnsRecords[domain] = make([]porkbun.DNSRecord, len(records))
+	for i, record := range records {
+		record.Priority = priority(record.Priority)
+		m.dnsRecords[domain][i] = record
+	}
+}
+
+func (m *Server) SetURLForwards(domain string, forwards []porkbun.URLForward) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.urlForwards[domain] = forwards
+}
+
+func (m *Server) SetDNSSECRecords(domain string, records []porkbun.DNSSECRecord) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.dnssec[domain] = records
+}
+
+func (m *Server) SetGlueRecords(domain string, glue map[string][]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.glue[domain] = glue
+}
+
+func (m *Server) SetSSLBundle(domain string, bundle porkbun.SSLBundle) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sslBundles[domain] = bundle
+}
+
+func (m *Server) SetDomains(domains []porkbun.Domain) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.domains = domains
+}
+
+func (m *Server) SetDomainAvailability(domain string, availability porkbun.DomainAvailability) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.available[domain] = availability
+}
+
+func (m *Server) SetPricing(pricing map[string]porkbun.TLDPricing) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.pricing = pricing
+}
+
+// SetRateLimited makes the next requests fail the way Porkbun does when throttling.
+func (m *Server) SetRateLimited(requests int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.rateLimited = requests
+}
+
+// SetFailure makes every request fail with the given message, or succeed again if it's empty.
+func (m *Server) SetFailure(message string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.failure = message
+}
+
+// Requests returns the number of requests served for a route pattern, e.g. "/dns/retrieve/{domain}".
+func (m *Server) Requests(pattern string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.requests[pattern]
+}
+
+func (m *Server) ResetRequests() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = make(map[string]int)
+}
+
+// UserAgent returns the User-Agent header of the last request.
+func (m *Server) UserAgent() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.userAgent
+}
+
+func (m *Server) DNSRecords(domain string) []porkbun.DNSRecord {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]porkbun.DNSRecord(nil), m.dnsRecords[domain]...)
 }
 
 func (m *Server) addPorkbunHandlers() {
+	m.mux.HandleFunc("/ping", func(rw http.ResponseWriter, req *http.Request) {
+		host, _, _ := net.SplitHostPort(req.RemoteAddr)
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"yourIp": %q
+		}`, host)))
+	})
+
+	m.mux.HandleFunc("/domain/listAll", func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		var b struct {
+			Start string `json:"start"`
+		}
+		_ = json.Unmarshal(body, &b)
+
+		// Porkbun returns domains in pages of 1000.
+		start, _ := strconv.Atoi(b.Start)
+		start = min(start, len(m.domains))
+		end := min(start+1000, len(m.domains))
+
+		ds, _ := json.Marshal(append([]porkbun.Domain{}, m.domains[start:end]...))
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"domains": %s
+		}`, ds)))
+	})
+
+	updateAutoRenew := func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		var b struct {
+			Status  string   `json:"status"`
+			Domains []string `json:"domains"`
+		}
+		_ = json.Unmarshal(body, &b)
+
+		domains := b.Domains
+		if domain := req.PathValue("domain"); domain != "" {
+			domains = []string{domain}
+		}
+
+		results := make(map[string]map[string]string)
+		for _, domain := range domains {
+			results[domain] = map[string]string{
+				"status":  "FAILURE",
+				"message": "Domain not found",
+			}
+
+			for i, d := range m.domains {
+				if d.Domain == domain {
+					m.domains[i].AutoRenew = b.Status == "on"
+					results[domain] = map[string]string{
+						"status":  "SUCCESS",
+						"message": "Auto renew status updated.",
+					}
+				}
+			}
+		}
+
+		rs, _ := json.Marshal(results)
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"results": %s
+		}`, rs)))
+	}
+	m.mux.HandleFunc("/domain/updateAutoRenew", updateAutoRenew)
+	m.mux.HandleFunc("/domain/updateAutoRenew/{domain}", updateAutoRenew)
+
+	m.mux.HandleFunc("/domain/checkDomain/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		availability, found := m.available[domain]
+
+		rw.Header().Set("Content-Type", "application/json")
+		if !found {
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "Invalid domain."
+			}`))
+			return
+		}
+
+		a, _ := json.Marshal(availability)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"response": %s
+		}`, a)))
+	})
+
+	m.mux.HandleFunc("/domain/create/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		availability := m.available[domain]
+
+		body, _ := io.ReadAll(req.Body)
+		var b struct {
+			Cost         int    `json:"cost"`
+			AgreeToTerms string `json:"agreeToTerms"`
+		}
+		_ = json.Unmarshal(body, &b)
+
+		price, _ := strconv.ParseFloat(availability.Price, 64)
+
+		rw.Header().Set("Content-Type", "application/json")
+		switch {
+		case !bool(availability.Available):
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "Domain is not available."
+			}`))
+			return
+		case b.AgreeToTerms != "yes":
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "You must agree to the terms of service."
+			}`))
+			return
+		case b.Cost != int(math.Round(price*100)):
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "The cost does not match the price of the domain."
+			}`))
+			return
+		}
+
+		availability.Available = false
+		m.available[domain] = availability
+		m.domains = append(m.domains, porkbun.Domain{
+			Domain:     domain,
+			Status:     "ACTIVE",
+			TLD:        domain[strings.LastIndex(domain, ".")+1:],
+			CreateDate: "2024-01-01 00:00:00",
+			ExpireDate: "2025-01-01 00:00:00",
+		})
+
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"domain": "%s",
+			"cost": %d,
+			"orderId": %d
+		}`, domain, b.Cost, len(m.domains))))
+	})
+
+	m.mux.HandleFunc("/pricing/get", func(rw http.ResponseWriter, req *http.Request) {
+		p, _ := json.Marshal(m.pricing)
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"pricing": %s
+		}`, p)))
+	})
+
 	m.mux.HandleFunc("/domain/getNs/{domain}", func(rw http.ResponseWriter, req *http.Request) {
 		domain := req.PathValue("domain")
 		nameservers, found := m.nameservers[domain]
@@ -94,6 +438,208 @@ func (m *Server) addPorkbunHandlers() {
 		}`))
 	})
 
+	m.mux.HandleFunc("/domain/addUrlForward/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+
+		body, _ := io.ReadAll(req.Body)
+		var b porkbun.URLForward
+		_ = json.Unmarshal(body, &b)
+
+		m.lastURLForwardID++
+		b.ID = strconv.Itoa(m.lastURLForwardID)
+
+		m.urlForwards[domain] = append(m.urlForwards[domain], b)
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	})
+
+	m.mux.HandleFunc("/domain/getUrlForwarding/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		forwards, ok := m.urlForwards[domain]
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if !ok {
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "Invalid domain."
+			}`))
+			return
+		}
+
+		if forwards == nil {
+			forwards = []porkbun.URLForward{}
+		}
+
+		fs, _ := json.Marshal(forwards)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"forwards": %s
+		}`, fs)))
+	})
+
+	m.mux.HandleFunc("/domain/deleteUrlForward/{domain}/{id}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		id := req.PathValue("id")
+
+		for i, f := range m.urlForwards[domain] {
+			if f.ID == id {
+				m.urlForwards[domain] = append(m.urlForwards[domain][:i], m.urlForwards[domain][i+1:]...)
+				break
+			}
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	})
+
+	m.mux.HandleFunc("/dns/createDnssecRecord/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+
+		body, _ := io.ReadAll(req.Body)
+		var b porkbun.DNSSECRecord
+		_ = json.Unmarshal(body, &b)
+
+		m.dnssec[domain] = append(m.dnssec[domain], b)
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	})
+
+	m.mux.HandleFunc("/dns/getDnssecRecords/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		records, ok := m.dnssec[domain]
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if !ok {
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "Invalid domain."
+			}`))
+			return
+		}
+
+		byKeyTag := make(map[string]porkbun.DNSSECRecord)
+		for _, r := range records {
+			byKeyTag[r.KeyTag] = r
+		}
+
+		// Like Porkbun, return an empty array rather than an empty object when there are no records.
+		rs := []byte("[]")
+		if len(byKeyTag) > 0 {
+			rs, _ = json.Marshal(byKeyTag)
+		}
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"records": %s
+		}`, rs)))
+	})
+
+	m.mux.HandleFunc("/dns/deleteDnssecRecord/{domain}/{keyTag}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		keyTag := req.PathValue("keyTag")
+
+		for i, r := range m.dnssec[domain] {
+			if r.KeyTag == keyTag {
+				m.dnssec[domain] = append(m.dnssec[domain][:i], m.dnssec[domain][i+1:]...)
+				break
+			}
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	})
+
+	setGlue := func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		subdomain := req.PathValue("subdomain")
+
+		body, _ := io.ReadAll(req.Body)
+		var b struct {
+			IPs []string `json:"ips"`
+		}
+		_ = json.Unmarshal(body, &b)
+
+		if m.glue[domain] == nil {
+			m.glue[domain] = make(map[string][]string)
+		}
+		m.glue[domain][subdomain] = b.IPs
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	}
+	m.mux.HandleFunc("/domain/createGlue/{domain}/{subdomain}", setGlue)
+	m.mux.HandleFunc("/domain/updateGlue/{domain}/{subdomain}", setGlue)
+
+	m.mux.HandleFunc("/domain/deleteGlue/{domain}/{subdomain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		subdomain := req.PathValue("subdomain")
+
+		delete(m.glue[domain], subdomain)
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	})
+
+	m.mux.HandleFunc("/domain/getGlue/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+
+		hosts := []interface{}{}
+		for subdomain, ips := range m.glue[domain] {
+			addresses := map[string][]string{"v4": {}, "v6": {}}
+			for _, ip := range ips {
+				if net.ParseIP(ip).To4() != nil {
+					addresses["v4"] = append(addresses["v4"], ip)
+				} else {
+					addresses["v6"] = append(addresses["v6"], ip)
+				}
+			}
+
+			hosts = append(hosts, []interface{}{fqdn(subdomain, domain), addresses})
+		}
+
+		hs, _ := json.Marshal(hosts)
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"hosts": %s
+		}`, hs)))
+	})
+
+	m.mux.HandleFunc("/ssl/retrieve/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		bundle, found := m.sslBundles[domain]
+
+		rw.Header().Set("Content-Type", "application/json")
+		if !found {
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "The SSL certificate is not ready for this domain."
+			}`))
+			return
+		}
+
+		b, _ := json.Marshal(bundle)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			%s
+		}`, b[1:len(b)-1])))
+	})
+
 	m.mux.HandleFunc("/dns/create/{domain}", func(rw http.ResponseWriter, req *http.Request) {
 		domain := req.PathValue("domain")
 
@@ -104,12 +650,12 @@ func (m *Server) addPorkbunHandlers() {
 		_ = json.Unmarshal(body, &b)
 
 		if b.ID == "" {
-			b.ID = strconv.Itoa(rand.Intn(1000))
+			m.lastDNSRecordID++
+			b.ID = strconv.Itoa(m.lastDNSRecordID)
 		}
 
-		if b.Name != "" {
-			b.Name = fmt.Sprintf("%s.%s", b.Name, domain)
-		}
+		b.Name = fqdn(b.Name, domain)
+		b.Priority = priority(b.Priority)
 
 		m.dnsRecords[domain] = append(m.dnsRecords[domain], b)
 		_, _ = rw.Write([]byte(fmt.Sprintf(`{
@@ -118,6 +664,31 @@ func (m *Server) addPorkbunHandlers() {
 		}`, b.ID)))
 	})
 
+	m.mux.HandleFunc("/dns/retrieve/{domain}", func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		records, ok := m.dnsRecords[domain]
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		if !ok {
+			_, _ = rw.Write([]byte(`{
+				"status": "FAILURE",
+				"message": "Invalid domain."
+			}`))
+			return
+		}
+
+		if records == nil {
+			records = []porkbun.DNSRecord{}
+		}
+
+		rs, _ := json.Marshal(records)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"records": %s
+		}`, rs)))
+	})
+
 	m.mux.HandleFunc("/dns/retrieve/{domain}/{id}", func(rw http.ResponseWriter, req *http.Request) {
 		domain := req.PathValue("domain")
 		id := req.PathValue("id")
@@ -168,14 +739,12 @@ func (m *Server) addPorkbunHandlers() {
 		var b porkbun.DNSRecord
 		_ = json.Unmarshal(body, &b)
 
-		for _, r := range m.dnsRecords[domain] {
+		for i, r := range m.dnsRecords[domain] {
 			if r.ID == id {
-				r.Type = b.Type
-				r.Content = b.Content
-
-				if b.Name != "" {
-					r.Name = fmt.Sprintf("%s.%s", b.Name, domain)
-				}
+				b.ID = r.ID
+				b.Name = fqdn(b.Name, domain)
+				b.Priority = priority(b.Priority)
+				m.dnsRecords[domain][i] = b
 			}
 		}
 
@@ -209,4 +778,97 @@ func (m *Server) addPorkbunHandlers() {
 			"status": "SUCCESS"
 		}`))
 	})
+	retrieveByNameType := func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		records := m.findDNSRecordsByNameType(domain, req.PathValue("type"), req.PathValue("subdomain"))
+
+		rw.Header().Set("Content-Type", "application/json")
+
+		rs, _ := json.Marshal(records)
+		_, _ = rw.Write([]byte(fmt.Sprintf(`{
+			"status": "SUCCESS",
+			"records": %s
+		}`, rs)))
+	}
+	m.mux.HandleFunc("/dns/retrieveByNameType/{domain}/{type}", retrieveByNameType)
+	m.mux.HandleFunc("/dns/retrieveByNameType/{domain}/{type}/{subdomain}", retrieveByNameType)
+
+	editByNameType := func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		records := m.findDNSRecordsByNameType(domain, req.PathValue("type"), req.PathValue("subdomain"))
+
+		body, _ := io.ReadAll(req.Body)
+		var b porkbun.DNSRecord
+		_ = json.Unmarshal(body, &b)
+
+		for i, r := range m.dnsRecords[domain] {
+			for _, found := range records {
+				if r.ID == found.ID {
+					m.dnsRecords[domain][i].Content = b.Content
+					m.dnsRecords[domain][i].TTL = b.TTL
+					m.dnsRecords[domain][i].Priority = priority(b.Priority)
+					m.dnsRecords[domain][i].Notes = b.Notes
+				}
+			}
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	}
+	m.mux.HandleFunc("/dns/editByNameType/{domain}/{type}", editByNameType)
+	m.mux.HandleFunc("/dns/editByNameType/{domain}/{type}/{subdomain}", editByNameType)
+
+	deleteByNameType := func(rw http.ResponseWriter, req *http.Request) {
+		domain := req.PathValue("domain")
+		records := m.findDNSRecordsByNameType(domain, req.PathValue("type"), req.PathValue("subdomain"))
+
+		for _, found := range records {
+			for i, r := range m.dnsRecords[domain] {
+				if r.ID == found.ID {
+					m.dnsRecords[domain] = append(m.dnsRecords[domain][:i], m.dnsRecords[domain][i+1:]...)
+					break
+				}
+			}
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_, _ = rw.Write([]byte(`{
+			"status": "SUCCESS"
+		}`))
+	}
+	m.mux.HandleFunc("/dns/deleteByNameType/{domain}/{type}", deleteByNameType)
+	m.mux.HandleFunc("/dns/deleteByNameType/{domain}/{type}/{subdomain}", deleteByNameType)
+}
+
+func (m *Server) findDNSRecordsByNameType(domain, recordType, subdomain string) []porkbun.DNSRecord {
+	name := fqdn(subdomain, domain)
+
+	found := []porkbun.DNSRecord{}
+	for _, r := range m.dnsRecords[domain] {
+		if r.Name == name && r.Type == recordType {
+			found = append(found, r)
+		}
+	}
+
+	return found
+}
+
+// Porkbun stores record names as FQDNs, with the root domain itself being used for records without a subdomain.
+func fqdn(subdomain, domain string) string {
+	if subdomain == "" {
+		return domain
+	}
+
+	return fmt.Sprintf("%s.%s", subdomain, domain)
+}
+
+// Porkbun returns a priority of 0 for records created without one, whatever their type.
+func priority(prio string) string {
+	if prio == "" {
+		return "0"
+	}
+
+	return prio
 }
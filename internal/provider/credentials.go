@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// credentials holds the API key pair, either key being empty if it's not been found yet.
+type credentials struct {
+	APIKey       string `json:"api_key"`
+	SecretAPIKey string `json:"secret_api_key"`
+}
+
+func (c *credentials) complete() bool {
+	return c.APIKey != "" && c.SecretAPIKey != ""
+}
+
+// fillMissing takes whichever keys are still missing from other.
+func (c *credentials) fillMissing(other credentials) {
+	if c.APIKey == "" {
+		c.APIKey = other.APIKey
+	}
+	if c.SecretAPIKey == "" {
+		c.SecretAPIKey = other.SecretAPIKey
+	}
+}
+
+// loadCredentials resolves each API key from the first of these sources to provide it:
+//
+//  1. `api_key` / `secret_api_key`
+//  2. `api_key_file` / `secret_api_key_file`
+//  3. PORKBUN_API_KEY / PORKBUN_SECRET_API_KEY
+//  4. PORKBUN_API_KEY_FILE / PORKBUN_SECRET_API_KEY_FILE
+//  5. `credentials_process`, or PORKBUN_CREDENTIALS_PROCESS
+//  6. `profile` of `shared_credentials_file`, or their PORKBUN_PROFILE and PORKBUN_SHARED_CREDENTIALS_FILE variables
+//
+// Keys still missing afterwards are left empty for the caller to report.
+func loadCredentials(ctx context.Context, config PorkbunProviderConfigurationModel, diags *diag.Diagnostics) credentials {
+	var creds credentials
+
+	creds.APIKey = loadKey(config.APIKey, config.APIKeyFile, "api_key", "PORKBUN_API_KEY", diags)
+	creds.SecretAPIKey = loadKey(config.SecretAPIKey, config.SecretAPIKeyFile, "secret_api_key", "PORKBUN_SECRET_API_KEY", diags)
+	if creds.complete() || diags.HasError() {
+		return creds
+	}
+
+	command, commandEnvSet := configOrEnv(config.CredentialsProcess, "PORKBUN_CREDENTIALS_PROCESS")
+	if command != "" {
+		processCreds, err := runCredentialsProcess(ctx, command)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("credentials_process"),
+				consts.ErrInvalidConfigurationValue,
+				"The provider cannot obtain API keys from the credentials process"+fromEnv(commandEnvSet, "PORKBUN_CREDENTIALS_PROCESS")+": "+err.Error(),
+			)
+			return creds
+		}
+		creds.fillMissing(processCreds)
+		if creds.complete() {
+			return creds
+		}
+	}
+
+	file, fileEnvSet := configOrEnv(config.SharedCredentialsFile, "PORKBUN_SHARED_CREDENTIALS_FILE")
+	profile, profileEnvSet := configOrEnv(config.Profile, "PORKBUN_PROFILE")
+	// A missing file only matters when it's been asked for.
+	explicit := file != "" || profile != ""
+	if file == "" {
+		file = defaultSharedCredentialsFile()
+	}
+	if profile == "" {
+		profile = "default"
+	}
+	if file == "" {
+		return creds
+	}
+
+	sharedCreds, err := readSharedCredentials(file, profile)
+	if err != nil {
+		if isNotExist(err) && !explicit {
+			return creds
+		}
+
+		diags.AddAttributeError(
+			path.Root("shared_credentials_file"),
+			consts.ErrInvalidConfigurationValue,
+			fmt.Sprintf("The provider cannot read profile %q of the shared credentials file %q%s: %s",
+				profile, file, fromEnv(fileEnvSet || profileEnvSet, "PORKBUN_SHARED_CREDENTIALS_FILE or PORKBUN_PROFILE"), err),
+		)
+		return creds
+	}
+	creds.fillMissing(sharedCreds)
+
+	return creds
+}
+
+// loadKey resolves a single key from its attribute, its file attribute, or their environment variables.
+func loadKey(value, file types.String, attribute, env string, diags *diag.Diagnostics) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	if !file.IsNull() {
+		key, err := readCredentialFile(file.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(attribute+"_file"),
+				consts.ErrInvalidConfigurationValue,
+				fmt.Sprintf("The provider cannot read %q from file: %s", attribute, err),
+			)
+		}
+		return key
+	}
+
+	if key := os.Getenv(env); key != "" {
+		return key
+	}
+
+	if keyFile := os.Getenv(env + "_FILE"); keyFile != "" {
+		key, err := readCredentialFile(keyFile)
+		if err != nil {
+			diags.AddError(
+				consts.ErrInvalidConfigurationValue,
+				fmt.Sprintf("The provider cannot read %q from the file set by the %s_FILE environment variable: %s", attribute, env, err),
+			)
+		}
+		return key
+	}
+
+	return ""
+}
+
+// configOrEnv returns the configured value, or else the environment variable and whether it was the source.
+func configOrEnv(value types.String, env string) (string, bool) {
+	if !value.IsNull() {
+		return value.ValueString(), false
+	}
+
+	envValue := os.Getenv(env)
+	return envValue, envValue != ""
+}
+
+func fromEnv(set bool, env string) string {
+	if !set {
+		return ""
+	}
+
+	return " (set by " + env + ")"
+}
+
+// readCredentialFile returns the content of a file holding a single key, e.g. a mounted secret, without surrounding
+// whitespace.
+func readCredentialFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+// runCredentialsProcess runs command with the shell of the platform and parses the credentials it prints to stdout
+// as JSON, e.g. `{"api_key": "pk1_...", "secret_api_key": "sk1_..."}`.
+func runCredentialsProcess(ctx context.Context, command string) (credentials, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return credentials{}, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var creds credentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return credentials{}, fmt.Errorf("parsing output as JSON failed: %w", err)
+	}
+
+	return creds, nil
+}
+
+// defaultSharedCredentialsFile returns ~/.config/porkbun/credentials.
+func defaultSharedCredentialsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".config", "porkbun", "credentials")
+}
+
+// readSharedCredentials reads a profile from an INI style file of the form:
+//
+//	[default]
+//	api_key        = pk1_...
+//	secret_api_key = sk1_...
+func readSharedCredentials(path, profile string) (credentials, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return credentials{}, err
+	}
+	defer file.Close()
+
+	var creds credentials
+	found := false
+	current := ""
+
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			found = found || current == profile
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return credentials{}, fmt.Errorf("line %d is neither a [profile] nor a key = value pair", lineNumber)
+		}
+		if current != profile {
+			continue
+		}
+
+		switch strings.TrimSpace(key) {
+		case "api_key":
+			creds.APIKey = strings.TrimSpace(value)
+		case "secret_api_key":
+			creds.SecretAPIKey = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return credentials{}, err
+	}
+
+	if !found {
+		return credentials{}, fmt.Errorf("profile %q not found", profile)
+	}
+
+	return creds, nil
+}
+
+// isNotExist reports whether err means a file doesn't exist.
+func isNotExist(err error) bool {
+	return errors.Is(err, fs.ErrNotExist)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLoadCredentials(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return file
+	}
+
+	apiKeyFile := writeFile("apikey", "pk1_file\n")
+	sharedFile := writeFile("credentials", `
+		[default]
+		api_key        = pk1_default
+		secret_api_key = sk1_default
+
+		[ci]
+		api_key        = pk1_ci
+		secret_api_key = sk1_ci
+	`)
+
+	testCases := []struct {
+		name     string
+		config   PorkbunProviderConfigurationModel
+		env      map[string]string
+		expected credentials
+		err      bool
+	}{
+		{
+			name: "attributes take precedence",
+			config: PorkbunProviderConfigurationModel{
+				APIKey:       types.StringValue("pk1_attr"),
+				SecretAPIKey: types.StringValue("sk1_attr"),
+				APIKeyFile:   types.StringValue(apiKeyFile),
+			},
+			env:      map[string]string{"PORKBUN_SHARED_CREDENTIALS_FILE": sharedFile},
+			expected: credentials{"pk1_attr", "sk1_attr"},
+		},
+		{
+			name:     "file attribute over environment variable",
+			config:   PorkbunProviderConfigurationModel{APIKeyFile: types.StringValue(apiKeyFile)},
+			env:      map[string]string{"PORKBUN_API_KEY": "pk1_env", "PORKBUN_SECRET_API_KEY": "sk1_env"},
+			expected: credentials{"pk1_file", "sk1_env"},
+		},
+		{
+			name:     "file environment variable",
+			env:      map[string]string{"PORKBUN_API_KEY_FILE": apiKeyFile, "PORKBUN_SECRET_API_KEY": "sk1_env"},
+			expected: credentials{"pk1_file", "sk1_env"},
+		},
+		{
+			name: "credentials process fills missing keys",
+			config: PorkbunProviderConfigurationModel{
+				APIKey:             types.StringValue("pk1_attr"),
+				CredentialsProcess: types.StringValue(`echo '{"api_key": "pk1_process", "secret_api_key": "sk1_process"}'`),
+			},
+			expected: credentials{"pk1_attr", "sk1_process"},
+		},
+		{
+			name:   "failing credentials process",
+			config: PorkbunProviderConfigurationModel{CredentialsProcess: types.StringValue("echo denied >&2; exit 1")},
+			err:    true,
+		},
+		{
+			name:     "shared credentials default profile",
+			env:      map[string]string{"PORKBUN_SHARED_CREDENTIALS_FILE": sharedFile},
+			expected: credentials{"pk1_default", "sk1_default"},
+		},
+		{
+			name:     "shared credentials named profile",
+			env:      map[string]string{"PORKBUN_SHARED_CREDENTIALS_FILE": sharedFile, "PORKBUN_PROFILE": "ci"},
+			expected: credentials{"pk1_ci", "sk1_ci"},
+		},
+		{
+			name: "unknown profile",
+			config: PorkbunProviderConfigurationModel{
+				SharedCredentialsFile: types.StringValue(sharedFile),
+				Profile:               types.StringValue("missing"),
+			},
+			err: true,
+		},
+		{
+			name:     "missing default shared credentials file is ignored",
+			expected: credentials{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, env := range []string{
+				"PORKBUN_API_KEY", "PORKBUN_SECRET_API_KEY", "PORKBUN_API_KEY_FILE", "PORKBUN_SECRET_API_KEY_FILE",
+				"PORKBUN_CREDENTIALS_PROCESS", "PORKBUN_SHARED_CREDENTIALS_FILE", "PORKBUN_PROFILE",
+			} {
+				t.Setenv(env, "")
+			}
+			t.Setenv("HOME", dir)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			var diags diag.Diagnostics
+			creds := loadCredentials(context.Background(), tc.config, &diags)
+			if diags.HasError() != tc.err {
+				t.Fatalf("expected error %t, got %v", tc.err, diags)
+			}
+			if !tc.err && creds != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, creds)
+			}
+		})
+	}
+}
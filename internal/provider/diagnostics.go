@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// addAPIError adds err as an error diagnostic. Known Porkbun failures are explained along with how to fix them,
+// anything else is reported under summary as is.
+func addAPIError(diags *diag.Diagnostics, summary string, err error) {
+	addAPIErrorAt(diags, path.Empty(), summary, err)
+}
+
+// addDomainAPIError is addAPIError for resources and data sources with a domain attribute, which failures caused by
+// the domain are pointed at.
+func addDomainAPIError(diags *diag.Diagnostics, summary string, err error) {
+	addAPIErrorAt(diags, path.Root("domain"), summary, err)
+}
+
+func addAPIErrorAt(diags *diag.Diagnostics, domainPath path.Path, summary string, err error) {
+	var apiErr *porkbun.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	detail := func(remediation string) string {
+		return fmt.Sprintf("%s: %s\n\n%s", summary, err, remediation)
+	}
+	addDomainError := func(summary, detail string) {
+		if domainPath.Equal(path.Empty()) {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddAttributeError(domainPath, summary, detail)
+		}
+	}
+
+	switch {
+	case errors.Is(err, porkbun.ErrAPIAccessDisabled):
+		addDomainError(
+			consts.ErrAPIAccessDisabled,
+			detail("API access has to be enabled for each domain separately. "+
+				"Enable it under Details > API Access of the domain on the Domain Management page of Porkbun."),
+		)
+	case errors.Is(err, porkbun.ErrAuth):
+		diags.AddError(
+			consts.ErrInvalidAPIKey,
+			detail("Check that api_key and secret_api_key of the provider, or the PORKBUN_API_KEY and PORKBUN_SECRET_API_KEY "+
+				"environment variables, hold a valid key pair from the API Access page of Porkbun. "+
+				"If the key restricts access by IP address, also check that requests come from an allowed address."),
+		)
+	case errors.Is(err, porkbun.ErrNotFound) && strings.Contains(strings.ToLower(apiErr.Message), "domain"):
+		addDomainError(
+			consts.ErrDomainNotInAccount,
+			detail("Check that the domain is spelled correctly and belongs to the account of the API key. "+
+				"If you manage several accounts through provider aliases, check that the provider meta-argument "+
+				"points at the alias for the account that owns the domain."),
+		)
+	case errors.Is(err, porkbun.ErrDuplicate):
+		diags.AddError(
+			consts.ErrAlreadyExists,
+			detail("Porkbun already has an identical object. Import it to manage it with Terraform instead."),
+		)
+	default:
+		diags.AddError(summary, err.Error())
+	}
+}
+
+// modifyPlanCheckDomain is the ModifyPlan of resources with a domain attribute, checking that the planned domain is
+// one of the provider's.
+func modifyPlanCheckDomain(ctx context.Context, client *porkbun.Client, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var domain types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	checkAllowedDomain(client, domain, &resp.Diagnostics)
+}
+
+// checkAllowedDomain adds an error on the domain attribute if domain isn't in the `domains` of the provider
+// configuration, which usually means the resource or data source uses the provider alias of the wrong account.
+func checkAllowedDomain(client *porkbun.Client, domain types.String, diags *diag.Diagnostics) {
+	if client == nil || domain.IsNull() || domain.IsUnknown() || client.AllowsDomain(domain.ValueString()) {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("domain"),
+		consts.ErrDomainNotAllowed,
+		fmt.Sprintf("%q is not one of the domains this porkbun provider configuration manages, which are: %s.\n\n"+
+			"Set the provider meta-argument, e.g. provider = porkbun.<alias>, to the alias whose \"domains\" include %q, "+
+			"or add the domain to the \"domains\" of this provider configuration if it belongs to its account.",
+			domain.ValueString(), strings.Join(client.AllowedDomains(), ", "), domain.ValueString()),
+	)
+}
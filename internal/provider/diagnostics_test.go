@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAPIErrorDiagnostics(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	config := providerConfig + `
+		resource "porkbun_dns_record" "test" {
+			domain  = "example.com"
+			type    = "A"
+			content = "1.2.3.4"
+		}
+	`
+
+	testCases := []struct {
+		message string
+		err     string
+	}{
+		{"Domain is not opted in to API access.", "API access disabled for domain"},
+		{"Invalid API key. (002)", "Invalid Porkbun API key"},
+		{"Invalid domain.", "Domain not in account"},
+		{"Duplicate record.", "Duplicate DNS record"},
+		{"Something else went wrong.", "Unable to create DNS record"},
+	}
+
+	steps := make([]resource.TestStep, len(testCases))
+	for i, tc := range testCases {
+		message := tc.message
+		steps[i] = resource.TestStep{
+			PreConfig:   func() { mockbun.SetFailure(message) },
+			Config:      config,
+			ExpectError: regexp.MustCompile(tc.err),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
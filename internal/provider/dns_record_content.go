@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Structured alternatives to the raw content of SRV, CAA and TLSA records, rendered into the format Porkbun expects.
+
+type DNSRecordSRVModel struct {
+	Weight types.Int64  `tfsdk:"weight"`
+	Port   types.Int64  `tfsdk:"port"`
+	Target types.String `tfsdk:"target"`
+}
+
+type DNSRecordCAAModel struct {
+	Flags types.Int64  `tfsdk:"flags"`
+	Tag   types.String `tfsdk:"tag"`
+	Value types.String `tfsdk:"value"`
+}
+
+type DNSRecordTLSAModel struct {
+	Usage        types.Int64  `tfsdk:"usage"`
+	Selector     types.Int64  `tfsdk:"selector"`
+	MatchingType types.Int64  `tfsdk:"matching_type"`
+	Data         types.String `tfsdk:"data"`
+}
+
+func dnsRecordContentBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"srv": schema.SingleNestedBlock{
+			MarkdownDescription: "The content of an `SRV` record, as an alternative to `content`.",
+			Attributes: map[string]schema.Attribute{
+				"weight": schema.Int64Attribute{
+					MarkdownDescription: "The relative weight of the target among targets of the same priority.",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 65535),
+					},
+				},
+				"port": schema.Int64Attribute{
+					MarkdownDescription: "The port of the service on the target.",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 65535),
+					},
+				},
+				"target": schema.StringAttribute{
+					MarkdownDescription: "The hostname of the machine providing the service.",
+					Optional:            true,
+				},
+			},
+		},
+		"caa": schema.SingleNestedBlock{
+			MarkdownDescription: "The content of a `CAA` record, as an alternative to `content`.",
+			Attributes: map[string]schema.Attribute{
+				"flags": schema.Int64Attribute{
+					MarkdownDescription: "The flags of the record, usually 0.",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 255),
+					},
+				},
+				"tag": schema.StringAttribute{
+					MarkdownDescription: "The property tag, e.g. `issue`, `issuewild` or `iodef`.",
+					Optional:            true,
+				},
+				"value": schema.StringAttribute{
+					MarkdownDescription: "The value of the property, without quotes, e.g. `letsencrypt.org`.",
+					Optional:            true,
+				},
+			},
+		},
+		"tlsa": schema.SingleNestedBlock{
+			MarkdownDescription: "The content of a `TLSA` record, as an alternative to `content`.",
+			Attributes: map[string]schema.Attribute{
+				"usage": schema.Int64Attribute{
+					MarkdownDescription: "The certificate usage, from 0 to 3.",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 3),
+					},
+				},
+				"selector": schema.Int64Attribute{
+					MarkdownDescription: "Whether the full certificate (0) or only its public key (1) is matched.",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 1),
+					},
+				},
+				"matching_type": schema.Int64Attribute{
+					MarkdownDescription: "Whether data is the exact selected content (0), its SHA-256 hash (1) or its SHA-512 hash (2).",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 2),
+					},
+				},
+				"data": schema.StringAttribute{
+					MarkdownDescription: "The certificate association data, hex encoded.",
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func (m *DNSRecordSRVModel) content() string {
+	return fmt.Sprintf("%d %d %s", m.Weight.ValueInt64(), m.Port.ValueInt64(), m.Target.ValueString())
+}
+
+func (m *DNSRecordCAAModel) content() string {
+	return fmt.Sprintf("%d %s %q", m.Flags.ValueInt64(), m.Tag.ValueString(), m.Value.ValueString())
+}
+
+func (m *DNSRecordTLSAModel) content() string {
+	return fmt.Sprintf("%d %d %d %s", m.Usage.ValueInt64(), m.Selector.ValueInt64(), m.MatchingType.ValueInt64(), m.Data.ValueString())
+}
+
+// parseUints parses exactly n space separated unsigned integers from the start of content, returning them along with
+// the rest of the content.
+func parseUints(content string, n int) ([]int64, string, bool) {
+	fields := strings.SplitN(content, " ", n+1)
+	if len(fields) != n+1 {
+		return nil, "", false
+	}
+
+	values := make([]int64, n)
+	for i := range values {
+		value, err := strconv.ParseUint(fields[i], 10, 16)
+		if err != nil {
+			return nil, "", false
+		}
+		values[i] = int64(value)
+	}
+
+	return values, fields[n], true
+}
+
+func parseSRVContent(content string) (*DNSRecordSRVModel, bool) {
+	values, target, ok := parseUints(content, 2)
+	if !ok {
+		return nil, false
+	}
+
+	return &DNSRecordSRVModel{
+		Weight: types.Int64Value(values[0]),
+		Port:   types.Int64Value(values[1]),
+		Target: types.StringValue(target),
+	}, true
+}
+
+func parseCAAContent(content string) (*DNSRecordCAAModel, bool) {
+	values, rest, ok := parseUints(content, 1)
+	if !ok {
+		return nil, false
+	}
+
+	tag, quoted, ok := strings.Cut(rest, " ")
+	if !ok {
+		return nil, false
+	}
+
+	value, err := strconv.Unquote(quoted)
+	if err != nil {
+		return nil, false
+	}
+
+	return &DNSRecordCAAModel{
+		Flags: types.Int64Value(values[0]),
+		Tag:   types.StringValue(tag),
+		Value: types.StringValue(value),
+	}, true
+}
+
+func parseTLSAContent(content string) (*DNSRecordTLSAModel, bool) {
+	values, data, ok := parseUints(content, 3)
+	if !ok {
+		return nil, false
+	}
+
+	return &DNSRecordTLSAModel{
+		Usage:        types.Int64Value(values[0]),
+		Selector:     types.Int64Value(values[1]),
+		MatchingType: types.Int64Value(values[2]),
+		Data:         types.StringValue(data),
+	}, true
+}
@@ -2,17 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
@@ -21,12 +24,15 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DNSRecordResource{}
-	_ resource.ResourceWithImportState = &DNSRecordResource{}
+	_ resource.Resource                   = &DNSRecordResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordResource{}
+	_ resource.ResourceWithModifyPlan     = &DNSRecordResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordResource{}
 )
 
 type DNSRecordResource struct {
-	client *porkbun.Client
+	client        *porkbun.Client
+	defaultDomain string
 }
 
 func NewDNSRecordResource() resource.Resource {
@@ -46,16 +52,26 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
-				MarkdownDescription: "The domain of the record.",
-				Required:            true,
+				MarkdownDescription: "The domain of the record. " +
+					"Defaults to the `default_domain` of the provider, unless `fqdn` is configured.",
+				Optional: true,
+				Computed: true,
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The subdomain for the record being created/updated/deleted, not including the domain itself. " +
 					"Leave blank to target the root domain. Use * for a wildcard record.",
 				Optional: true,
-				Default:  stringdefault.StaticString(""),
 				Computed: true,
 			},
+			"fqdn": schema.StringAttribute{
+				MarkdownDescription: "The fully qualified name of the record, e.g. `www.example.com`, as a shorthand for `domain` and `name`. " +
+					"The domain is found by matching against the domains of your account.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("domain"), path.MatchRoot("name")),
+				},
+			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of record being created." +
 					"Valid types are `A`, `MX`, `CNAME`, `ALIAS`, `TXT`, `NS`, `AAAA`, `SRV`, `TLSA`, and `CAA`",
@@ -66,8 +82,10 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 			},
 			"content": schema.StringAttribute{
 				MarkdownDescription: "The answer content for the record. " +
-					"Please see the DNS management popup from the domain management console for proper formatting of each record type.",
-				Required: true,
+					"Please see the DNS management popup from the domain management console for proper formatting of each record type. " +
+					"Required unless one of the `srv`, `caa` or `tlsa` blocks is configured.",
+				Optional: true,
+				Computed: true,
 			},
 			"ttl": schema.Int64Attribute{
 				MarkdownDescription: "The time to live in seconds for the record. The minimum and the default is 600 seconds.",
@@ -79,14 +97,22 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"priority": schema.Int64Attribute{
-				MarkdownDescription: "The priority of the record for those that support it.",
+				MarkdownDescription: "The priority of the record. Required for `MX` and `SRV` records, and not supported by other types.",
 				Optional:            true,
 			},
 			"notes": schema.StringAttribute{
 				MarkdownDescription: "Comments or notes about the DNS record. This field has no effect on DNS responses.",
 				Optional:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt an existing record with the same name and type instead of creating a new one. " +
+					"The adopted record is updated to match the configuration. Creation fails if more than one such record exists.",
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+				Computed: true,
+			},
 		},
+		Blocks: dnsRecordContentBlocks(),
 	}
 }
 
@@ -94,11 +120,63 @@ type DNSRecordResourceModel struct {
 	ID       types.String `tfsdk:"id"`
 	Domain   types.String `tfsdk:"domain"`
 	Name     types.String `tfsdk:"name"`
+	FQDN     types.String `tfsdk:"fqdn"`
 	Type     types.String `tfsdk:"type"`
 	Content  types.String `tfsdk:"content"`
 	TTL      types.Int64  `tfsdk:"ttl"`
 	Priority types.Int64  `tfsdk:"priority"`
 	Notes    types.String `tfsdk:"notes"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+
+	SRV  *DNSRecordSRVModel  `tfsdk:"srv"`
+	CAA  *DNSRecordCAAModel  `tfsdk:"caa"`
+	TLSA *DNSRecordTLSAModel `tfsdk:"tlsa"`
+}
+
+// structuredContent renders the configured srv, caa or tlsa block into the record content. The content is unknown if
+// any attribute of the block is, and ok is false if no block is configured.
+func (m *DNSRecordResourceModel) structuredContent() (content types.String, ok bool) {
+	switch {
+	case m.SRV != nil:
+		if m.SRV.Weight.IsUnknown() || m.SRV.Port.IsUnknown() || m.SRV.Target.IsUnknown() {
+			return types.StringUnknown(), true
+		}
+		return types.StringValue(m.SRV.content()), true
+	case m.CAA != nil:
+		if m.CAA.Flags.IsUnknown() || m.CAA.Tag.IsUnknown() || m.CAA.Value.IsUnknown() {
+			return types.StringUnknown(), true
+		}
+		return types.StringValue(m.CAA.content()), true
+	case m.TLSA != nil:
+		if m.TLSA.Usage.IsUnknown() || m.TLSA.Selector.IsUnknown() || m.TLSA.MatchingType.IsUnknown() || m.TLSA.Data.IsUnknown() {
+			return types.StringUnknown(), true
+		}
+		return types.StringValue(m.TLSA.content()), true
+	}
+
+	return types.StringNull(), false
+}
+
+func (m *DNSRecordResourceModel) toDNSRecord() porkbun.DNSRecord {
+	record := porkbun.DNSRecord{
+		Name:    m.Name.ValueString(),
+		Type:    m.Type.ValueString(),
+		Content: m.Content.ValueString(),
+		TTL:     strconv.Itoa(int(m.TTL.ValueInt64())),
+	}
+
+	priority := int(m.Priority.ValueInt64())
+	if priority != 0 {
+		record.Priority = strconv.Itoa(priority)
+	}
+
+	notes := m.Notes.ValueString()
+	if notes != "" {
+		record.Notes = notes
+	}
+
+	return record
 }
 
 func (r *DNSRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -107,46 +185,233 @@ func (r *DNSRecordResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*porkbun.Client)
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			consts.ErrUnexpectedResourceConfigureType,
-			fmt.Sprintf("Expected *porkbun.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.defaultDomain = providerData.DefaultDomain
 }
 
-func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+func (r *DNSRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data DNSRecordResourceModel
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Type.IsNull() || data.Type.IsUnknown() {
+		return
+	}
+
+	recordType := data.Type.ValueString()
+
+	blocks := map[string]bool{"SRV": data.SRV != nil, "CAA": data.CAA != nil, "TLSA": data.TLSA != nil}
+	configured := 0
+	for blockType, set := range blocks {
+		if !set {
+			continue
+		}
+
+		configured++
+		if blockType != recordType {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(strings.ToLower(blockType)),
+				consts.ErrInvalidConfigurationValue,
+				fmt.Sprintf("The %s block can only be used for %s records.", strings.ToLower(blockType), blockType),
+			)
+		}
+	}
+
+	switch {
+	case configured > 1:
+		resp.Diagnostics.AddError(consts.ErrInvalidConfigurationValue, "Only one of the srv, caa and tlsa blocks can be configured.")
+	case configured == 1 && !data.Content.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			consts.ErrInvalidConfigurationValue,
+			"content can't be configured together with the srv, caa or tlsa blocks.",
+		)
+	case configured == 0 && data.Content.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			consts.ErrInvalidConfigurationValue,
+			"Either content or one of the srv, caa and tlsa blocks must be configured.",
+		)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	record := porkbun.DNSRecord{
-		Name:    data.Name.ValueString(),
-		Type:    data.Type.ValueString(),
-		Content: data.Content.ValueString(),
-		TTL:     strconv.Itoa(int(data.TTL.ValueInt64())),
+	if missing := missingBlockAttributes(data); missing != "" {
+		resp.Diagnostics.AddError(consts.ErrInvalidConfigurationValue, missing)
+		return
 	}
 
-	priority := int(data.Priority.ValueInt64())
-	if priority != 0 {
-		record.Priority = strconv.Itoa(priority)
+	if content, ok := data.structuredContent(); ok {
+		data.Content = content
 	}
 
-	notes := data.Notes.ValueString()
-	if notes != "" {
-		record.Notes = data.Notes.ValueString()
+	if !data.Content.IsNull() && !data.Content.IsUnknown() {
+		if problem := validateDNSRecordContent(recordType, data.Content.ValueString()); problem != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("content"), consts.ErrInvalidConfigurationValue, problem+".")
+		}
+	}
+
+	if problem := validateDNSRecordPriority(recordType, data.Priority); problem != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("priority"), consts.ErrInvalidConfigurationValue, problem+".")
+	}
+}
+
+// ModifyPlan resolves the domain and name of the record from either of `fqdn`, `domain` and `name`, or the default
+// domain of the provider, and checks that the domain is one of the provider's.
+func (r *DNSRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve when destroying.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	ID, err := r.client.CreateDNSRecord(ctx, data.Domain.ValueString(), record)
+	switch {
+	case !config.FQDN.IsNull():
+		// The domains of the account can't be listed before the provider has been configured.
+		if config.FQDN.IsUnknown() || r.client == nil {
+			plan.Domain = types.StringUnknown()
+			plan.Name = types.StringUnknown()
+			break
+		}
+
+		domainNames, err := r.client.DomainNames(ctx)
+		if err != nil {
+			addDomainAPIError(&resp.Diagnostics, "Unable to list domains", err)
+			return
+		}
+
+		domain, name, ok := splitFQDN(config.FQDN.ValueString(), domainNames)
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fqdn"),
+				consts.ErrInvalidConfigurationValue,
+				fmt.Sprintf("%q doesn't belong to any domain of your account.", config.FQDN.ValueString()),
+			)
+			return
+		}
+
+		plan.Domain = types.StringValue(domain)
+		plan.Name = types.StringValue(name)
+	default:
+		if config.Domain.IsNull() && r.client == nil {
+			// The default domain isn't known before the provider has been configured.
+			plan.Domain = types.StringUnknown()
+		} else if config.Domain.IsNull() {
+			if r.defaultDomain == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("domain"),
+					consts.ErrInvalidConfigurationValue,
+					`Either "domain" or "fqdn" must be configured when the provider has no "default_domain".`,
+				)
+				return
+			}
+
+			plan.Domain = types.StringValue(r.defaultDomain)
+		}
+
+		if config.Name.IsNull() {
+			plan.Name = types.StringValue("")
+		}
+	}
+
+	checkAllowedDomain(r.client, plan.Domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if content, ok := plan.structuredContent(); ok {
+		plan.Content = content
+	}
+
+	// A configured fqdn is kept as written, only the domain and name derived from it are normalized.
+	if !config.FQDN.IsNull() {
+		plan.FQDN = config.FQDN
+	} else if plan.Domain.IsUnknown() || plan.Name.IsUnknown() {
+		plan.FQDN = types.StringUnknown()
+	} else {
+		plan.FQDN = types.StringValue(joinFQDN(plan.Name.ValueString(), plan.Domain.ValueString()))
+	}
+
+	// Records can't be moved between domains.
+	if !req.State.Raw.IsNull() {
+		var state DNSRecordResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if !plan.Domain.Equal(state.Domain) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("domain"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record := data.toDNSRecord()
+	domain := data.Domain.ValueString()
+
+	if data.AdoptExisting.ValueBool() {
+		existing, err := r.client.RetrieveDNSRecordsByNameType(ctx, domain, record.Type, record.Name)
+		if err != nil {
+			addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+			return
+		}
+
+		if len(existing) > 1 {
+			resp.Diagnostics.AddError(
+				"Unable to adopt DNS record",
+				fmt.Sprintf("Found %d %s records named %q for %s, expected at most one. "+
+					"Import the record to manage using the import ID of format \"FQDN/recordID\" instead.",
+					len(existing), record.Type, record.Name, domain),
+			)
+			return
+		}
+
+		if len(existing) == 1 {
+			err = r.client.EditDNSRecordsByNameType(ctx, domain, record.Type, record.Name, record)
+			if err != nil {
+				addDomainAPIError(&resp.Diagnostics, "Unable to update DNS record", err)
+				return
+			}
+
+			data.ID = types.StringValue(existing[0].ID)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	ID, err := r.client.CreateDNSRecord(ctx, domain, record)
+	if errors.Is(err, porkbun.ErrDuplicate) {
+		resp.Diagnostics.AddError(
+			consts.ErrDuplicateDNSRecord,
+			fmt.Sprintf("Unable to create DNS record: %s\n\n"+
+				"A record with the same name, type and content already exists. "+
+				"Import the existing record, or set adopt_existing to manage it instead.", err),
+		)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to create DNS record", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to create DNS record", err)
 		return
 	}
 
@@ -164,26 +429,33 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	domain := data.Domain.ValueString()
 	record, err := r.client.RetrieveDNSRecord(ctx, domain, data.ID.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// The record has been deleted outside of Terraform, plan to recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to retrieve DNS record", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS record", err)
 		return
 	}
 
-	name := record.Name
-	if name == domain {
-		name = ""
-	} else {
-		name = strings.ReplaceAll(name, fmt.Sprintf(".%s", domain), "")
+	data.Name = types.StringValue(trimDomainFromName(record.Name, domain))
+	if fqdn := joinFQDN(data.Name.ValueString(), domain); !sameFQDN(data.FQDN.ValueString(), fqdn) {
+		data.FQDN = types.StringValue(fqdn)
 	}
-
-	data.Name = types.StringValue(name)
 	data.Type = types.StringValue(record.Type)
-	data.Content = types.StringValue(record.Content)
+	// Keep the content as configured unless it means something else than what Porkbun returns.
+	if data.Content.IsNull() || !sameDNSRecordContent(record.Type, data.Content.ValueString(), record.Content) {
+		data.Content = types.StringValue(record.Content)
+		readStructuredContent(&data)
+	}
 
 	ttl, _ := strconv.Atoi(record.TTL)
 	data.TTL = types.Int64Value(int64(ttl))
 
-	if record.Priority != "" {
+	// Porkbun returns a priority of 0 for record types that don't support one.
+	data.Priority = types.Int64Null()
+	if dnsRecordUsesPriority(record.Type) && record.Priority != "" {
 		priority, _ := strconv.Atoi(record.Priority)
 		data.Priority = types.Int64Value(int64(priority))
 	}
@@ -208,28 +480,13 @@ func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	record := porkbun.DNSRecord{
-		Name:    plan.Name.ValueString(),
-		Type:    plan.Type.ValueString(),
-		Content: plan.Content.ValueString(),
-		TTL:     strconv.Itoa(int(plan.TTL.ValueInt64())),
-	}
-
-	priority := int(plan.Priority.ValueInt64())
-	if priority != 0 {
-		record.Priority = strconv.Itoa(priority)
-	}
-
-	notes := plan.Notes.ValueString()
-	if notes != "" {
-		record.Notes = plan.Notes.ValueString()
-	}
+	record := plan.toDNSRecord()
 
 	plan.Domain = state.Domain
 	plan.ID = state.ID
 	err := r.client.EditDNSRecord(ctx, plan.Domain.ValueString(), plan.ID.ValueString(), record)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update DNS record", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to update DNS record", err)
 		return
 	}
 
@@ -245,8 +502,8 @@ func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	err := r.client.DeleteDNSRecord(ctx, data.Domain.ValueString(), data.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to delete DNS record", err.Error())
+	if err != nil && !errors.Is(err, porkbun.ErrNotFound) {
+		addDomainAPIError(&resp.Diagnostics, "Unable to delete DNS record", err)
 		return
 	}
 
@@ -254,20 +511,91 @@ func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *DNSRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	importId := strings.SplitN(req.ID, "/", 2)
+	importId := strings.SplitN(req.ID, "/", 3)
 
 	var domain string
 	var id string
-	if len(importId) == 2 {
+	switch len(importId) {
+	case 2:
 		domain, id = importId[0], importId[1]
-	} else {
+	case 3:
+		var name, recordType string
+		domain, name, recordType = importId[0], importId[1], importId[2]
+
+		records, err := r.client.RetrieveDNSRecordsByNameType(ctx, domain, recordType, name)
+		if err != nil {
+			addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+			return
+		}
+
+		if len(records) != 1 {
+			resp.Diagnostics.AddError(
+				"Unable to import DNS record",
+				fmt.Sprintf("Found %d %s records named %q for %s, expected exactly one. "+
+					"Use the import ID of format \"FQDN/recordID\" to import a specific record instead.",
+					len(records), recordType, name, domain),
+			)
+			return
+		}
+
+		id = records[0].ID
+	default:
 		resp.Diagnostics.AddError(
 			"Invalid import ID specified",
-			"Use the import ID of format \"FQDN/recordID\" to import DNS records.",
+			"Use the import ID of format \"FQDN/recordID\" or \"FQDN/name/type\" to import DNS records.",
 		)
 		return
 	}
 
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
+
+// readStructuredContent parses the content back into whichever of the srv, caa and tlsa blocks is in use. A block is
+// dropped if the content no longer fits its format, which shows up as a difference to the configuration.
+func readStructuredContent(data *DNSRecordResourceModel) {
+	content := data.Content.ValueString()
+	switch {
+	case data.SRV != nil:
+		data.SRV, _ = parseSRVContent(content)
+	case data.CAA != nil:
+		data.CAA, _ = parseCAAContent(content)
+	case data.TLSA != nil:
+		data.TLSA, _ = parseTLSAContent(content)
+	}
+}
+
+// missingBlockAttributes returns a message naming the first attribute not configured in the srv, caa or tlsa block.
+func missingBlockAttributes(data DNSRecordResourceModel) string {
+	var missing []string
+	switch {
+	case data.SRV != nil:
+		for name, value := range map[string]attr.Value{"weight": data.SRV.Weight, "port": data.SRV.Port, "target": data.SRV.Target} {
+			if value.IsNull() {
+				missing = append(missing, "srv."+name)
+			}
+		}
+	case data.CAA != nil:
+		for name, value := range map[string]attr.Value{"flags": data.CAA.Flags, "tag": data.CAA.Tag, "value": data.CAA.Value} {
+			if value.IsNull() {
+				missing = append(missing, "caa."+name)
+			}
+		}
+	case data.TLSA != nil:
+		for name, value := range map[string]attr.Value{
+			"usage": data.TLSA.Usage, "selector": data.TLSA.Selector, "matching_type": data.TLSA.MatchingType, "data": data.TLSA.Data,
+		} {
+			if value.IsNull() {
+				missing = append(missing, "tlsa."+name)
+			}
+		}
+	}
+
+	if len(missing) == 0 {
+		return ""
+	}
+
+	sort.Strings(missing)
+	return fmt.Sprintf("The attributes %s must be configured.", strings.Join(missing, ", "))
+}
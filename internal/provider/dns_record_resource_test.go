@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/mockbun"
 )
 
 func TestDNSRecordResource(t *testing.T) {
@@ -27,6 +32,13 @@ func TestDNSRecordResource(t *testing.T) {
 					resource.TestCheckResourceAttr("porkbun_dns_record.test", "content", "1.2.3.4"),
 				),
 			},
+			// Test import by name and type.
+			{
+				ResourceName:      "porkbun_dns_record.test",
+				ImportStateId:     "example.com//A",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Test update and read with subdomain.
 			// TODO: How would I pass ID from previous porkbun_dns_record.test into the new block so that I can test?
 			// {
@@ -48,3 +60,345 @@ func TestDNSRecordResource(t *testing.T) {
 		},
 	})
 }
+
+func TestDNSRecordResourceAdoptExisting(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDNSRecords("example.com", []porkbun.DNSRecord{
+		{ID: "1234", Name: "www.example.com", Type: "A", Content: "1.2.3.4", TTL: "600"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_record" "test" {
+						domain         = "example.com"
+						name           = "www"
+						type           = "A"
+						content        = "4.3.2.1"
+						adopt_existing = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_record.test", "id", "1234"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.test", "name", "www"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.test", "content", "4.3.2.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceDeletedOutOfBand(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	config := providerConfig + `
+		resource "porkbun_dns_record" "test" {
+			domain  = "example.com"
+			name    = "www"
+			type    = "A"
+			content = "1.2.3.4"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a record deleted outside of Terraform is planned to be re-created instead of failing.
+			{
+				PreConfig: func() {
+					mockbun.SetDNSRecords("example.com", []porkbun.DNSRecord{})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test re-create.
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckMockDNSRecords(mockbun, "example.com", []string{"www.example.com A 1.2.3.4"}),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceSharedReads(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	config := providerConfig + `
+		resource "porkbun_dns_record" "test" {
+			count   = 10
+			domain  = "example.com"
+			name    = "r${count.index}"
+			type    = "A"
+			content = "1.2.3.4"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(_ *terraform.State) error {
+					if creates := mockbun.Requests("/dns/create/{domain}"); creates != 10 {
+						return fmt.Errorf("expected 10 create requests, got %d", creates)
+					}
+					return nil
+				},
+			},
+			// Test that refreshing records of the same domain shares list requests instead of retrieving each by ID.
+			{
+				PreConfig: mockbun.ResetRequests,
+				Config:    config,
+				Check: func(_ *terraform.State) error {
+					if byID := mockbun.Requests("/dns/retrieve/{domain}/{id}"); byID != 0 {
+						return fmt.Errorf("expected no requests retrieving records by ID, got %d", byID)
+					}
+					if lists := mockbun.Requests("/dns/retrieve/{domain}"); lists == 0 || lists >= 10 {
+						return fmt.Errorf("expected refreshing 10 records to take between 1 and 9 list requests, got %d", lists)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceDomainShorthands(t *testing.T) {
+	mockbunServer := mockbun.New()
+	t.Cleanup(mockbunServer.Close)
+	mockbunServer.SetDomains([]porkbun.Domain{
+		{Domain: "example.com", Status: "ACTIVE", TLD: "com"},
+		{Domain: "example.co.uk", Status: "ACTIVE", TLD: "co.uk"},
+	})
+
+	providerConfig := fmt.Sprintf(`
+		provider "porkbun" {
+			api_key         = "apikey"
+			secret_api_key  = "secretapikey"
+			custom_base_url = "%s"
+			default_domain  = "example.com"
+		}
+	`, mockbunServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test create with the default domain and with an FQDN.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_record" "default" {
+						name    = "www"
+						type    = "A"
+						content = "1.2.3.4"
+					}
+
+					resource "porkbun_dns_record" "fqdn" {
+						fqdn    = "api.v2.example.co.uk"
+						type    = "A"
+						content = "1.2.3.4"
+					}
+
+					resource "porkbun_dns_record" "fqdn_as_written" {
+						fqdn    = "Mail.Example.co.uk."
+						type    = "A"
+						content = "1.2.3.4"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_record.default", "domain", "example.com"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.default", "fqdn", "www.example.com"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.fqdn", "domain", "example.co.uk"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.fqdn", "name", "api.v2"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.fqdn_as_written", "fqdn", "Mail.Example.co.uk."),
+					resource.TestCheckResourceAttr("porkbun_dns_record.fqdn_as_written", "name", "mail"),
+					testCheckMockDNSRecords(mockbunServer, "example.co.uk", []string{
+						"api.v2.example.co.uk A 1.2.3.4",
+						"mail.example.co.uk A 1.2.3.4",
+					}),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "porkbun_dns_record.fqdn",
+				ImportStateId:     "example.co.uk/api.v2/A",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceValidation(t *testing.T) {
+	providerConfig, _ := getProviderConfigWithMockServer(t)
+
+	testCases := []struct {
+		record string
+		err    string
+	}{
+		{`type = "A"` + "\n" + `content = "::1"`, "must be an IPv4 address"},
+		{`type = "AAAA"` + "\n" + `content = "1.2.3.4"`, "must be an IPv6 address"},
+		{`type = "CNAME"` + "\n" + `content = "not a hostname"`, "must be a hostname"},
+		{`type = "MX"` + "\n" + `content = "mx.example.com"`, "priority is required"},
+		{`type = "SRV"` + "\n" + `content = "5 sip.example.com"` + "\n" + `priority = 10`, "weight port target"},
+		{`type = "CAA"` + "\n" + `content = "0 issue letsencrypt.org"`, "flags tag"},
+		{`type = "TLSA"` + "\n" + `content = "3 1 1 abc"`, "hexadecimal"},
+		{`type = "TXT"` + "\n" + `content = "\"unterminated"`, "unterminated"},
+		{`type = "TXT"` + "\n" + `content = "text"` + "\n" + `priority = 1`, "priority is not supported"},
+	}
+
+	steps := make([]resource.TestStep, len(testCases))
+	for i, tc := range testCases {
+		steps[i] = resource.TestStep{
+			Config: providerConfig + fmt.Sprintf(`
+				resource "porkbun_dns_record" "test" {
+					domain = "example.com"
+					%s
+				}
+			`, tc.record),
+			ExpectError: regexp.MustCompile(tc.err),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps:                    steps,
+	})
+}
+
+func TestDNSRecordResourceStructuredContent(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test create and read of records rendered from blocks.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_record" "srv" {
+						domain   = "example.com"
+						name     = "_sip._tcp"
+						type     = "SRV"
+						priority = 10
+
+						srv {
+							weight = 5
+							port   = 5060
+							target = "sip.example.com"
+						}
+					}
+
+					resource "porkbun_dns_record" "caa" {
+						domain = "example.com"
+						type   = "CAA"
+
+						caa {
+							flags = 0
+							tag   = "issue"
+							value = "letsencrypt.org"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_record.srv", "content", "5 5060 sip.example.com"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.srv", "srv.port", "5060"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.caa", "content", `0 issue "letsencrypt.org"`),
+					resource.TestCheckResourceAttr("porkbun_dns_record.caa", "caa.value", "letsencrypt.org"),
+					testCheckMockDNSRecords(mockbun, "example.com", []string{
+						"_sip._tcp.example.com SRV 5 5060 sip.example.com",
+						`example.com CAA 0 issue "letsencrypt.org"`,
+					}),
+				),
+			},
+			// Test that blocks conflict with content.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_record" "tlsa" {
+						domain  = "example.com"
+						type    = "TLSA"
+						content = "3 1 1 0123abcd"
+
+						tlsa {
+							usage         = 3
+							selector      = 1
+							matching_type = 1
+							data          = "0123abcd"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("content can't be configured together"),
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceNormalization(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDNSRecords("example.com", nil)
+	config := providerConfig + `
+		resource "porkbun_dns_record" "aaaa" {
+			domain  = "example.com"
+			type    = "AAAA"
+			content = "2001:db8::1"
+		}
+
+		resource "porkbun_dns_record" "cname" {
+			domain  = "example.com"
+			name    = "www"
+			type    = "CNAME"
+			content = "Example.net."
+		}
+
+		resource "porkbun_dns_record" "null_mx" {
+			domain   = "example.com"
+			type     = "MX"
+			content  = "."
+			priority = 0
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that the priority of 0 Porkbun returns for record types without one is ignored.
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("porkbun_dns_record.aaaa", "priority"),
+					resource.TestCheckNoResourceAttr("porkbun_dns_record.cname", "priority"),
+					resource.TestCheckResourceAttr("porkbun_dns_record.null_mx", "priority", "0"),
+				),
+			},
+			// Test that content equal once normalized doesn't cause a diff.
+			{
+				PreConfig: func() {
+					records := mockbun.DNSRecords("example.com")
+					for i := range records {
+						switch records[i].Type {
+						case "AAAA":
+							records[i].Content = "2001:DB8:0:0::1"
+						case "CNAME":
+							records[i].Content = "example.net"
+						}
+					}
+					mockbun.SetDNSRecords("example.com", records)
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Test import.
+			{
+				ResourceName:            "porkbun_dns_record.aaaa",
+				ImportStateId:           "example.com//AAAA",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+		},
+	})
+}
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DNSRecordSetResource{}
+	_ resource.ResourceWithImportState = &DNSRecordSetResource{}
+	_ resource.ResourceWithModifyPlan  = &DNSRecordSetResource{}
+)
+
+type DNSRecordSetResource struct {
+	client *porkbun.Client
+}
+
+func NewDNSRecordSetResource() resource.Resource {
+	return &DNSRecordSetResource{}
+}
+
+func (r *DNSRecordSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_set"
+}
+
+func (r *DNSRecordSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage all DNS records sharing a name and type, e.g. round-robin A records or multiple MX hosts.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the record set, of format `domain/name/type`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the records.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The subdomain of the records, not including the domain itself. " +
+					"Leave blank to target the root domain. Use * for a wildcard record.",
+				Optional: true,
+				Default:  stringdefault.StaticString(""),
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the records. " +
+					"Valid types are `A`, `MX`, `CNAME`, `ALIAS`, `TXT`, `NS`, `AAAA`, `SRV`, `TLSA`, and `CAA`",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "MX", "CNAME", "ALIAS", "TXT", "NS", "AAAA", "SRV", "TLSA", "CAA"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "The time to live in seconds for all records of the set. The minimum and the default is 600 seconds.",
+				Optional:            true,
+				Default:             int64default.StaticInt64(600),
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(600),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "The records of the set.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The answer content for the record.",
+							Required:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of the record for those that support it.",
+							Optional:            true,
+						},
+						"notes": schema.StringAttribute{
+							MarkdownDescription: "Comments or notes about the DNS record. This field has no effect on DNS responses.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type DNSRecordSetResourceModel struct {
+	ID      types.String                 `tfsdk:"id"`
+	Domain  types.String                 `tfsdk:"domain"`
+	Name    types.String                 `tfsdk:"name"`
+	Type    types.String                 `tfsdk:"type"`
+	TTL     types.Int64                  `tfsdk:"ttl"`
+	Records []DNSRecordSetResourceMember `tfsdk:"records"`
+}
+
+type DNSRecordSetResourceMember struct {
+	Content  types.String `tfsdk:"content"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Notes    types.String `tfsdk:"notes"`
+}
+
+func (m *DNSRecordSetResourceModel) toDNSRecord(member DNSRecordSetResourceMember) porkbun.DNSRecord {
+	record := porkbun.DNSRecord{
+		Name:    m.Name.ValueString(),
+		Type:    m.Type.ValueString(),
+		Content: member.Content.ValueString(),
+		TTL:     strconv.Itoa(int(m.TTL.ValueInt64())),
+		Notes:   member.Notes.ValueString(),
+	}
+
+	priority := int(member.Priority.ValueInt64())
+	if priority != 0 {
+		record.Priority = strconv.Itoa(priority)
+	}
+
+	return record
+}
+
+func (r *DNSRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DNSRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+}
+
+func (r *DNSRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", data.Domain.ValueString(), data.Name.ValueString(), data.Type.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	records, err := r.client.RetrieveDNSRecordsByNameType(ctx, domain, data.Type.ValueString(), data.Name.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+		return
+	}
+	records = normalizeDNSRecords(records, domain)
+
+	if len(records) > 0 {
+		ttl, _ := strconv.Atoi(records[0].TTL)
+		data.TTL = types.Int64Value(int64(ttl))
+	}
+
+	members := make([]DNSRecordSetResourceMember, 0, len(records))
+	for _, record := range records {
+		// Prefer the member as it's already known in state so that unset optional attributes remain null.
+		var known *DNSRecordSetResourceMember
+		for i := range data.Records {
+			expected := data.toDNSRecord(data.Records[i])
+			if sameDNSRecordContent(record.Type, expected.Content, record.Content) && expected.Priority == record.Priority && expected.Notes == record.Notes {
+				known = &data.Records[i]
+				break
+			}
+		}
+
+		if known != nil {
+			members = append(members, *known)
+			continue
+		}
+
+		member := DNSRecordSetResourceMember{
+			Content:  types.StringValue(record.Content),
+			Priority: types.Int64Null(),
+			Notes:    types.StringNull(),
+		}
+
+		if record.Priority != "" {
+			priority, _ := strconv.Atoi(record.Priority)
+			member.Priority = types.Int64Value(int64(priority))
+		}
+
+		if record.Notes != "" {
+			member.Notes = types.StringValue(record.Notes)
+		}
+
+		members = append(members, member)
+	}
+	data.Records = members
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSRecordSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSRecordsByNameType(ctx, data.Domain.ValueString(), data.Type.ValueString(), data.Name.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to delete DNS records", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := strings.SplitN(req.ID, "/", 3)
+	if len(importId) != 3 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID specified",
+			"Use the import ID of format \"FQDN/name/type\" to import DNS record sets.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), importId[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), importId[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), importId[2])...)
+}
+
+// reconcile creates, edits and deletes only the records that differ between the set and Porkbun.
+func (r *DNSRecordSetResource) reconcile(ctx context.Context, data *DNSRecordSetResourceModel, diags *diag.Diagnostics) {
+	domain := data.Domain.ValueString()
+	actual, err := r.client.RetrieveDNSRecordsByNameType(ctx, domain, data.Type.ValueString(), data.Name.ValueString())
+	if err != nil {
+		addDomainAPIError(diags, "Unable to retrieve DNS records", err)
+		return
+	}
+
+	desired := make([]porkbun.DNSRecord, len(data.Records))
+	for i, member := range data.Records {
+		desired[i] = data.toDNSRecord(member)
+	}
+
+	changes := diffDNSRecords(desired, normalizeDNSRecords(actual, domain), func(porkbun.DNSRecord) bool { return false })
+	applyDNSRecordChanges(ctx, r.client, domain, changes, diags)
+}
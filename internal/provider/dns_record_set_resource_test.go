@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDNSRecordSetResource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDNSRecords("example.com", []porkbun.DNSRecord{
+		{ID: "1", Name: "example.com", Type: "MX", Content: "mx1.example.net", TTL: "600", Priority: "10"},
+		{ID: "2", Name: "www.example.com", Type: "A", Content: "1.1.1.1", TTL: "600"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test create and read, existing records of the same name and type are reconciled.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_record_set" "test" {
+						domain = "example.com"
+						name   = "www"
+						type   = "A"
+
+						records = [
+							{ content = "1.1.1.1" },
+							{ content = "2.2.2.2" },
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_record_set.test", "id", "example.com/www/A"),
+					resource.TestCheckResourceAttr("porkbun_dns_record_set.test", "records.#", "2"),
+					testCheckMockDNSRecords(mockbun, "example.com", []string{
+						"example.com MX mx1.example.net",
+						"www.example.com A 1.1.1.1",
+						"www.example.com A 2.2.2.2",
+					}),
+				),
+			},
+			// Test update, only the changed members are touched.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_record_set" "test" {
+						domain = "example.com"
+						name   = "www"
+						type   = "A"
+
+						records = [
+							{ content = "1.1.1.1" },
+							{ content = "3.3.3.3" },
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_record_set.test", "records.#", "2"),
+					testCheckMockDNSRecords(mockbun, "example.com", []string{
+						"example.com MX mx1.example.net",
+						"www.example.com A 1.1.1.1",
+						"www.example.com A 3.3.3.3",
+					}),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "porkbun_dns_record_set.test",
+				ImportStateId:     "example.com/www/A",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
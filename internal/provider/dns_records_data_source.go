@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DNSRecordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSRecordsDataSource{}
+)
+
+type DNSRecordsDataSource struct {
+	client *porkbun.Client
+}
+
+func NewDNSRecordsDataSource() datasource.DataSource {
+	return &DNSRecordsDataSource{}
+}
+
+func (d *DNSRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+func (d *DNSRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get all DNS records of your domain, optionally filtered by name, type or content.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain to retrieve the records of.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return records with this subdomain, not including the domain itself. " +
+					"Use an empty string to only return records of the root domain.",
+				Optional: true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return records of this type.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "MX", "CNAME", "ALIAS", "TXT", "NS", "AAAA", "SRV", "TLSA", "CAA"),
+				},
+			},
+			"content_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return records whose content matches this regular expression.",
+				Optional:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "The DNS records matching the given filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the record.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The subdomain of the record, not including the domain itself.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the record.",
+							Computed:            true,
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The answer content of the record.",
+							Computed:            true,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "The time to live in seconds of the record.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of the record for those that support it.",
+							Computed:            true,
+						},
+						"notes": schema.StringAttribute{
+							MarkdownDescription: "Comments or notes about the record.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type DNSRecordsDataSourceModel struct {
+	Domain       types.String                      `tfsdk:"domain"`
+	Name         types.String                      `tfsdk:"name"`
+	Type         types.String                      `tfsdk:"type"`
+	ContentRegex types.String                      `tfsdk:"content_regex"`
+	Records      []DNSRecordsDataSourceRecordModel `tfsdk:"records"`
+}
+
+type DNSRecordsDataSourceRecordModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Content  types.String `tfsdk:"content"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Notes    types.String `tfsdk:"notes"`
+}
+
+func (d *DNSRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DNSRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DNSRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	checkAllowedDomain(d.client, state.Domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var contentRegex *regexp.Regexp
+	if !state.ContentRegex.IsNull() {
+		var err error
+		contentRegex, err = regexp.Compile(state.ContentRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content_regex"),
+				consts.ErrInvalidConfigurationValue,
+				fmt.Sprintf(`The value configured for "content_regex" is not a valid regular expression: %s`, err),
+			)
+			return
+		}
+	}
+
+	domain := state.Domain.ValueString()
+	records, err := d.client.RetrieveDNSRecords(ctx, domain)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+		return
+	}
+
+	tfRecords := make([]DNSRecordsDataSourceRecordModel, 0, len(records))
+	for _, record := range records {
+		name := trimDomainFromName(record.Name, domain)
+
+		if !state.Name.IsNull() && state.Name.ValueString() != name {
+			continue
+		}
+		if !state.Type.IsNull() && state.Type.ValueString() != record.Type {
+			continue
+		}
+		if contentRegex != nil && !contentRegex.MatchString(record.Content) {
+			continue
+		}
+
+		tfRecord := DNSRecordsDataSourceRecordModel{
+			ID:       types.StringValue(record.ID),
+			Name:     types.StringValue(name),
+			Type:     types.StringValue(record.Type),
+			Content:  types.StringValue(record.Content),
+			Priority: types.Int64Null(),
+			Notes:    types.StringNull(),
+		}
+
+		ttl, _ := strconv.Atoi(record.TTL)
+		tfRecord.TTL = types.Int64Value(int64(ttl))
+
+		if dnsRecordUsesPriority(record.Type) && record.Priority != "" {
+			priority, _ := strconv.Atoi(record.Priority)
+			tfRecord.Priority = types.Int64Value(int64(priority))
+		}
+
+		if record.Notes != "" {
+			tfRecord.Notes = types.StringValue(record.Notes)
+		}
+
+		tfRecords = append(tfRecords, tfRecord)
+	}
+	state.Records = tfRecords
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
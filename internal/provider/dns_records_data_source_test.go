@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDNSRecordsDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDNSRecords("example.com", []porkbun.DNSRecord{
+		{ID: "1", Name: "example.com", Type: "A", Content: "1.2.3.4", TTL: "600"},
+		{ID: "2", Name: "example.com", Type: "MX", Content: "mx1.example.net", TTL: "600", Priority: "10"},
+		{ID: "3", Name: "example.com", Type: "MX", Content: "mx2.example.net", TTL: "600", Priority: "20"},
+		{ID: "4", Name: "www.example.com", Type: "CNAME", Content: "example.com", TTL: "600"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test without filters.
+			{
+				Config: providerConfig + `
+					data "porkbun_dns_records" "test" {
+						domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.#", "4"),
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.0.name", ""),
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.3.name", "www"),
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.3.type", "CNAME"),
+				),
+			},
+			// Test with filters.
+			{
+				Config: providerConfig + `
+					data "porkbun_dns_records" "test" {
+						domain        = "example.com"
+						name          = ""
+						type          = "MX"
+						content_regex = "^mx2\\."
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.0.id", "3"),
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.0.content", "mx2.example.net"),
+					resource.TestCheckResourceAttr("data.porkbun_dns_records.test", "records.0.priority", "20"),
+				),
+			},
+		},
+	})
+}
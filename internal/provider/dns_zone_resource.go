@@ -0,0 +1,409 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DNSZoneResource{}
+	_ resource.ResourceWithImportState = &DNSZoneResource{}
+	_ resource.ResourceWithModifyPlan  = &DNSZoneResource{}
+)
+
+type DNSZoneResource struct {
+	client *porkbun.Client
+}
+
+func NewDNSZoneResource() resource.Resource {
+	return &DNSZoneResource{}
+}
+
+func (r *DNSZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *DNSZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manage every DNS record of your domain. " +
+			"Records that exist in the zone but are not declared in `records` are deleted, " +
+			"and destroying the zone deletes every record of the domain. " +
+			"Planning warns about the records that are going to be deleted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain of the zone.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the zone.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preserve_default_records": schema.BoolAttribute{
+				MarkdownDescription: "Leave NS records of the root domain and Porkbun's default parking records " +
+					"in place even when they are not declared in `records`.",
+				Optional: true,
+				Default:  booldefault.StaticBool(false),
+				Computed: true,
+			},
+			"records": schema.SetNestedAttribute{
+				MarkdownDescription: "Every DNS record of the zone.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The subdomain of the record, not including the domain itself. " +
+								"Leave unset to target the root domain. Use * for a wildcard record.",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the record. " +
+								"Valid types are `A`, `MX`, `CNAME`, `ALIAS`, `TXT`, `NS`, `AAAA`, `SRV`, `TLSA`, and `CAA`",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("A", "MX", "CNAME", "ALIAS", "TXT", "NS", "AAAA", "SRV", "TLSA", "CAA"),
+							},
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "The answer content for the record.",
+							Required:            true,
+						},
+						"ttl": schema.Int64Attribute{
+							MarkdownDescription: "The time to live in seconds for the record. The minimum and the default is 600 seconds.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(600),
+							},
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of the record for those that support it.",
+							Optional:            true,
+						},
+						"notes": schema.StringAttribute{
+							MarkdownDescription: "Comments or notes about the DNS record. This field has no effect on DNS responses.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type DNSZoneResourceModel struct {
+	ID                     types.String         `tfsdk:"id"`
+	Domain                 types.String         `tfsdk:"domain"`
+	PreserveDefaultRecords types.Bool           `tfsdk:"preserve_default_records"`
+	Records                []DNSZoneRecordModel `tfsdk:"records"`
+}
+
+type DNSZoneRecordModel struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Content  types.String `tfsdk:"content"`
+	TTL      types.Int64  `tfsdk:"ttl"`
+	Priority types.Int64  `tfsdk:"priority"`
+	Notes    types.String `tfsdk:"notes"`
+}
+
+func (m *DNSZoneRecordModel) isFullyKnown() bool {
+	return !m.Name.IsUnknown() && !m.Type.IsUnknown() && !m.Content.IsUnknown() &&
+		!m.TTL.IsUnknown() && !m.Priority.IsUnknown() && !m.Notes.IsUnknown()
+}
+
+func (m *DNSZoneRecordModel) toDNSRecord() porkbun.DNSRecord {
+	record := porkbun.DNSRecord{
+		Name:    m.Name.ValueString(),
+		Type:    m.Type.ValueString(),
+		Content: m.Content.ValueString(),
+		TTL:     "600",
+	}
+
+	if !m.TTL.IsNull() {
+		record.TTL = strconv.Itoa(int(m.TTL.ValueInt64()))
+	}
+
+	priority := int(m.Priority.ValueInt64())
+	if priority != 0 {
+		record.Priority = strconv.Itoa(priority)
+	}
+
+	record.Notes = m.Notes.ValueString()
+
+	return record
+}
+
+func newDNSZoneRecordModel(record porkbun.DNSRecord) DNSZoneRecordModel {
+	m := DNSZoneRecordModel{
+		Name:     types.StringNull(),
+		Type:     types.StringValue(record.Type),
+		Content:  types.StringValue(record.Content),
+		Priority: types.Int64Null(),
+		Notes:    types.StringNull(),
+	}
+
+	if record.Name != "" {
+		m.Name = types.StringValue(record.Name)
+	}
+
+	ttl, _ := strconv.Atoi(record.TTL)
+	m.TTL = types.Int64Value(int64(ttl))
+
+	if record.Priority != "" {
+		priority, _ := strconv.Atoi(record.Priority)
+		m.Priority = types.Int64Value(int64(priority))
+	}
+
+	if record.Notes != "" {
+		m.Notes = types.StringValue(record.Notes)
+	}
+
+	return m
+}
+
+func (r *DNSZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DNSZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+	// Nothing to surface when destroying, or before the provider has been configured.
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var domain types.String
+	var records types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("records"), &records)...)
+	if resp.Diagnostics.HasError() || domain.IsUnknown() || records.IsUnknown() {
+		return
+	}
+
+	var plan DNSZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, record := range plan.Records {
+		if !record.isFullyKnown() {
+			return
+		}
+	}
+
+	// Records about to be deleted are only in the plan if they were refreshed into state, which isn't the case for
+	// records that exist before the zone is created. List them all so that nothing is deleted unannounced.
+	changes, err := r.diff(ctx, &plan)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+		return
+	}
+
+	if len(changes.delete) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Planned DNS zone changes",
+		fmt.Sprintf("Applying will make the following changes to the DNS records of %s, "+
+			"deleting every record that is not declared in records:\n\n%s",
+			plan.Domain.ValueString(), changes.summary(plan.Domain.ValueString())),
+	)
+}
+
+func (r *DNSZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Domain
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	actual, err := r.retrieveDNSRecords(ctx, domain)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+		return
+	}
+
+	records := make([]DNSZoneRecordModel, 0, len(actual))
+	for _, record := range actual {
+		// Prefer the record as it's already known in state so that unset optional attributes remain null.
+		var known *DNSZoneRecordModel
+		for i := range data.Records {
+			if sameDNSRecord(data.Records[i].toDNSRecord(), record) {
+				known = &data.Records[i]
+				break
+			}
+		}
+
+		if known != nil {
+			records = append(records, *known)
+			continue
+		}
+
+		if data.PreserveDefaultRecords.ValueBool() && isDefaultDNSRecord(record) {
+			continue
+		}
+
+		records = append(records, newDNSZoneRecordModel(record))
+	}
+	data.Records = records
+	data.ID = data.Domain
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Domain
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSZoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	actual, err := r.retrieveDNSRecords(ctx, domain)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve DNS records", err)
+		return
+	}
+
+	// The zone owns every record of the domain, so it deletes them like Create deletes undeclared records, including
+	// records that drifted outside of Terraform. Preserved default records are left alone.
+	preserve := func(record porkbun.DNSRecord) bool {
+		return data.PreserveDefaultRecords.ValueBool() && isDefaultDNSRecord(record)
+	}
+	applyDNSRecordChanges(ctx, r.client, domain, diffDNSRecords(nil, actual, preserve), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("preserve_default_records"), false)...)
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
+
+// retrieveDNSRecords lists all records of the domain, with names stripped of the domain like they're configured.
+func (r *DNSZoneResource) retrieveDNSRecords(ctx context.Context, domain string) ([]porkbun.DNSRecord, error) {
+	records, err := r.client.RetrieveDNSRecords(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeDNSRecords(records, domain), nil
+}
+
+func (r *DNSZoneResource) diff(ctx context.Context, data *DNSZoneResourceModel) (dnsRecordChanges, error) {
+	actual, err := r.retrieveDNSRecords(ctx, data.Domain.ValueString())
+	if err != nil {
+		return dnsRecordChanges{}, err
+	}
+
+	desired := make([]porkbun.DNSRecord, len(data.Records))
+	for i, record := range data.Records {
+		desired[i] = record.toDNSRecord()
+	}
+
+	preserve := func(record porkbun.DNSRecord) bool {
+		return data.PreserveDefaultRecords.ValueBool() && isDefaultDNSRecord(record)
+	}
+
+	return diffDNSRecords(desired, actual, preserve), nil
+}
+
+func (r *DNSZoneResource) apply(ctx context.Context, data *DNSZoneResourceModel, diags *diag.Diagnostics) {
+	changes, err := r.diff(ctx, data)
+	if err != nil {
+		addDomainAPIError(diags, "Unable to retrieve DNS records", err)
+		return
+	}
+
+	applyDNSRecordChanges(ctx, r.client, data.Domain.ValueString(), changes, diags)
+}
+
+// isDefaultDNSRecord reports whether the record is one Porkbun manages by default, i.e. the NS records of the root
+// domain or the records pointing to Porkbun's parking page.
+func isDefaultDNSRecord(record porkbun.DNSRecord) bool {
+	if record.Type == "NS" && record.Name == "" {
+		return true
+	}
+
+	return record.Content == consts.DefaultParkingHost
+}
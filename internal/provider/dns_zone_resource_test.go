@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDNSZoneResource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDNSRecords("example.com", []porkbun.DNSRecord{
+		{ID: "1", Name: "example.com", Type: "NS", Content: "maceio.ns.porkbun.com", TTL: "86400"},
+		{ID: "2", Name: "example.com", Type: "ALIAS", Content: "pixie.porkbun.com", TTL: "600"},
+		{ID: "3", Name: "www.example.com", Type: "A", Content: "1.2.3.4", TTL: "600"},
+		{ID: "4", Name: "old.example.com", Type: "CNAME", Content: "example.com", TTL: "600"},
+	})
+
+	updatedConfig := providerConfig + `
+		resource "porkbun_dns_zone" "test" {
+			domain = "example.com"
+
+			records = [
+				{
+					type    = "NS"
+					content = "maceio.ns.porkbun.com"
+					ttl     = 86400
+				},
+				{
+					name    = "www"
+					type    = "A"
+					content = "4.3.2.1"
+					ttl     = 600
+				},
+			]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Test that destroying deletes every record of the zone, like creating deletes undeclared records.
+		CheckDestroy: testCheckMockDNSRecords(mockbun, "example.com", []string{}),
+		Steps: []resource.TestStep{
+			// Test create and read, undeclared records other than the default ones are deleted.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dns_zone" "test" {
+						domain                   = "example.com"
+						preserve_default_records = true
+
+						records = [
+							{
+								name    = "www"
+								type    = "A"
+								content = "4.3.2.1"
+							},
+							{
+								type     = "MX"
+								content  = "mx.example.net"
+								priority = 10
+							},
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_zone.test", "id", "example.com"),
+					resource.TestCheckResourceAttr("porkbun_dns_zone.test", "records.#", "2"),
+					testCheckMockDNSRecords(mockbun, "example.com", []string{
+						"example.com NS maceio.ns.porkbun.com",
+						"example.com ALIAS pixie.porkbun.com",
+						"www.example.com A 4.3.2.1",
+						"example.com MX mx.example.net",
+					}),
+				),
+			},
+			// Test update, default records are deleted once they're no longer preserved.
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dns_zone.test", "records.#", "2"),
+					testCheckMockDNSRecords(mockbun, "example.com", []string{
+						"example.com NS maceio.ns.porkbun.com",
+						"www.example.com A 4.3.2.1",
+					}),
+				),
+			},
+			// Test that a record added outside of Terraform shows up in the plan as a record to remove.
+			{
+				PreConfig: func() {
+					records := append(mockbun.DNSRecords("example.com"), porkbun.DNSRecord{
+						ID: "99", Name: "ui.example.com", Type: "TXT", Content: "added in the web UI", TTL: "600",
+					})
+					mockbun.SetDNSRecords("example.com", records)
+				},
+				Config:             updatedConfig,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test that applying removes it.
+			{
+				Config: updatedConfig,
+				Check: testCheckMockDNSRecords(mockbun, "example.com", []string{
+					"example.com NS maceio.ns.porkbun.com",
+					"www.example.com A 4.3.2.1",
+				}),
+			},
+			// Test import.
+			{
+				ResourceName:                         "porkbun_dns_zone.test",
+				ImportStateId:                        "example.com",
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
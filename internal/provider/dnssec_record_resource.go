@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DNSSECRecordResource{}
+	_ resource.ResourceWithImportState = &DNSSECRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &DNSSECRecordResource{}
+)
+
+type DNSSECRecordResource struct {
+	client *porkbun.Client
+}
+
+func NewDNSSECRecordResource() resource.Resource {
+	return &DNSSECRecordResource{}
+}
+
+func (r *DNSSECRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_record"
+}
+
+func (r *DNSSECRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Porkbun has no API to edit DS records, so every change replaces the record.
+	requiresReplace := []planmodifier.Int64{int64planmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Publish DS records of your domain at the registry for DNSSEC.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The key tag of the record.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the record.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_tag": schema.Int64Attribute{
+				MarkdownDescription: "The key tag of the DNSKEY the record refers to.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"algorithm": schema.Int64Attribute{
+				MarkdownDescription: "The DNSSEC algorithm number of the DNSKEY, e.g. `13` for ECDSAP256SHA256.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"digest_type": schema.Int64Attribute{
+				MarkdownDescription: "The algorithm used to create the digest, e.g. `2` for SHA-256.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"digest": schema.StringAttribute{
+				MarkdownDescription: "The digest of the DNSKEY.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_sig_life": schema.Int64Attribute{
+				MarkdownDescription: "The maximum signature life in seconds.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"key_data_flags": schema.Int64Attribute{
+				MarkdownDescription: "The flags of the DNSKEY, e.g. `257` for a key signing key.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"key_data_protocol": schema.Int64Attribute{
+				MarkdownDescription: "The protocol of the DNSKEY, which is always `3`.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"key_data_algorithm": schema.Int64Attribute{
+				MarkdownDescription: "The DNSSEC algorithm number of the DNSKEY.",
+				Optional:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"key_data_public_key": schema.StringAttribute{
+				MarkdownDescription: "The base64 encoded public key of the DNSKEY.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+type DNSSECRecordResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Domain           types.String `tfsdk:"domain"`
+	KeyTag           types.Int64  `tfsdk:"key_tag"`
+	Algorithm        types.Int64  `tfsdk:"algorithm"`
+	DigestType       types.Int64  `tfsdk:"digest_type"`
+	Digest           types.String `tfsdk:"digest"`
+	MaxSigLife       types.Int64  `tfsdk:"max_sig_life"`
+	KeyDataFlags     types.Int64  `tfsdk:"key_data_flags"`
+	KeyDataProtocol  types.Int64  `tfsdk:"key_data_protocol"`
+	KeyDataAlgorithm types.Int64  `tfsdk:"key_data_algorithm"`
+	KeyDataPublicKey types.String `tfsdk:"key_data_public_key"`
+}
+
+func (r *DNSSECRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DNSSECRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+}
+
+func (r *DNSSECRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSSECRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optional := func(value types.Int64) string {
+		if value.IsNull() {
+			return ""
+		}
+		return strconv.FormatInt(value.ValueInt64(), 10)
+	}
+
+	keyTag := strconv.FormatInt(data.KeyTag.ValueInt64(), 10)
+	record := porkbun.DNSSECRecord{
+		KeyTag:           keyTag,
+		Algorithm:        strconv.FormatInt(data.Algorithm.ValueInt64(), 10),
+		DigestType:       strconv.FormatInt(data.DigestType.ValueInt64(), 10),
+		Digest:           data.Digest.ValueString(),
+		MaxSigLife:       optional(data.MaxSigLife),
+		KeyDataFlags:     optional(data.KeyDataFlags),
+		KeyDataProtocol:  optional(data.KeyDataProtocol),
+		KeyDataAlgorithm: optional(data.KeyDataAlgorithm),
+		KeyDataPublicKey: data.KeyDataPublicKey.ValueString(),
+	}
+
+	err := r.client.CreateDNSSECRecord(ctx, data.Domain.ValueString(), record)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to create DNSSEC record", err)
+		return
+	}
+
+	data.ID = types.StringValue(keyTag)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSSECRecordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := r.client.GetDNSSECRecords(ctx, data.Domain.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// The domain is no longer in the account.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get DNSSEC records", err)
+		return
+	}
+
+	var record *porkbun.DNSSECRecord
+	for i := range records {
+		if records[i].KeyTag == data.ID.ValueString() {
+			record = &records[i]
+			break
+		}
+	}
+
+	if record == nil {
+		// The DNSSEC record has been deleted outside of Terraform, plan to recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	keyTag, _ := strconv.ParseInt(record.KeyTag, 10, 64)
+	data.KeyTag = types.Int64Value(keyTag)
+
+	algorithm, _ := strconv.ParseInt(record.Algorithm, 10, 64)
+	data.Algorithm = types.Int64Value(algorithm)
+
+	digestType, _ := strconv.ParseInt(record.DigestType, 10, 64)
+	data.DigestType = types.Int64Value(digestType)
+
+	data.Digest = types.StringValue(record.Digest)
+
+	// Porkbun only returns the key data fields when the registry stores them, otherwise the configured values are kept.
+	optional := func(value string, current types.Int64) types.Int64 {
+		if value == "" {
+			return current
+		}
+		parsed, _ := strconv.ParseInt(value, 10, 64)
+		return types.Int64Value(parsed)
+	}
+
+	data.MaxSigLife = optional(record.MaxSigLife, data.MaxSigLife)
+	data.KeyDataFlags = optional(record.KeyDataFlags, data.KeyDataFlags)
+	data.KeyDataProtocol = optional(record.KeyDataProtocol, data.KeyDataProtocol)
+	data.KeyDataAlgorithm = optional(record.KeyDataAlgorithm, data.KeyDataAlgorithm)
+
+	if record.KeyDataPublicKey != "" {
+		data.KeyDataPublicKey = types.StringValue(record.KeyDataPublicKey)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there's nothing to update in place.
+	var data DNSSECRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSSECRecordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSSECRecord(ctx, data.Domain.ValueString(), data.ID.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to delete DNSSEC record", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := strings.SplitN(req.ID, "/", 2)
+
+	var domain string
+	var keyTag string
+	if len(importId) == 2 {
+		domain, keyTag = importId[0], importId[1]
+	} else {
+		resp.Diagnostics.AddError(
+			"Invalid import ID specified",
+			"Use the import ID of format \"FQDN/keyTag\" to import DNSSEC records.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyTag)...)
+}
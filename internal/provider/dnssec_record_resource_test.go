@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDNSSECRecordResource(t *testing.T) {
+	providerConfig, _ := getProviderConfigWithMockServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test create and read.
+			{
+				Config: providerConfig + `
+					resource "porkbun_dnssec_record" "test" {
+						domain      = "example.com"
+						key_tag     = 64087
+						algorithm   = 13
+						digest_type = 2
+						digest      = "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_dnssec_record.test", "id", "64087"),
+					resource.TestCheckResourceAttr("porkbun_dnssec_record.test", "key_tag", "64087"),
+					resource.TestCheckResourceAttr("porkbun_dnssec_record.test", "algorithm", "13"),
+					resource.TestCheckResourceAttr("porkbun_dnssec_record.test", "digest_type", "2"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "porkbun_dnssec_record.test",
+				ImportStateId:     "example.com/64087",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDNSSECRecordResourceDeletedOutOfBand(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	config := providerConfig + `
+		resource "porkbun_dnssec_record" "test" {
+			domain      = "example.com"
+			key_tag     = 64087
+			algorithm   = 13
+			digest_type = 2
+			digest      = "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a DNSSEC record deleted outside of Terraform is planned to be re-created instead of failing.
+			{
+				PreConfig: func() {
+					mockbun.SetDNSSECRecords("example.com", nil)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
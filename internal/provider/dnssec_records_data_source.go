@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DNSSECRecordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSSECRecordsDataSource{}
+)
+
+type DNSSECRecordsDataSource struct {
+	client *porkbun.Client
+}
+
+func NewDNSSECRecordsDataSource() datasource.DataSource {
+	return &DNSSECRecordsDataSource{}
+}
+
+func (d *DNSSECRecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_records"
+}
+
+func (d *DNSSECRecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get DS records of your domain published at the registry.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the domain.",
+				Required:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "The DS records of the domain, ordered by key tag.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_tag": schema.Int64Attribute{
+							MarkdownDescription: "The key tag of the DNSKEY the record refers to.",
+							Computed:            true,
+						},
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "The DNSSEC algorithm number of the DNSKEY.",
+							Computed:            true,
+						},
+						"digest_type": schema.Int64Attribute{
+							MarkdownDescription: "The algorithm used to create the digest.",
+							Computed:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "The digest of the DNSKEY.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type DNSSECRecordsDataSourceModel struct {
+	Domain  types.String                         `tfsdk:"domain"`
+	Records []DNSSECRecordsDataSourceRecordModel `tfsdk:"records"`
+}
+
+type DNSSECRecordsDataSourceRecordModel struct {
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+}
+
+func (d *DNSSECRecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DNSSECRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DNSSECRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	checkAllowedDomain(d.client, state.Domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := d.client.GetDNSSECRecords(ctx, state.Domain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get DNSSEC records", err)
+		return
+	}
+
+	tfRecords := make([]DNSSECRecordsDataSourceRecordModel, len(records))
+	for i, record := range records {
+		keyTag, _ := strconv.ParseInt(record.KeyTag, 10, 64)
+		algorithm, _ := strconv.ParseInt(record.Algorithm, 10, 64)
+		digestType, _ := strconv.ParseInt(record.DigestType, 10, 64)
+
+		tfRecords[i] = DNSSECRecordsDataSourceRecordModel{
+			KeyTag:     types.Int64Value(keyTag),
+			Algorithm:  types.Int64Value(algorithm),
+			DigestType: types.Int64Value(digestType),
+			Digest:     types.StringValue(record.Digest),
+		}
+	}
+	state.Records = tfRecords
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
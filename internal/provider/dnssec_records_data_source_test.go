@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDNSSECRecordsDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDNSSECRecords("example.com", []porkbun.DNSSECRecord{
+		{KeyTag: "64087", Algorithm: "13", DigestType: "2", Digest: "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"},
+		{KeyTag: "2371", Algorithm: "8", DigestType: "2", Digest: "1F987CC6583E92DF0890718C42C7B4F1E71DC3DA4D2B2F5ACF6D3E0D5C2D3F9E"},
+	})
+	mockbun.SetDNSSECRecords("example.org", []porkbun.DNSSECRecord{})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "porkbun_dnssec_records" "test" {
+						domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_dnssec_records.test", "records.#", "2"),
+					resource.TestCheckResourceAttr("data.porkbun_dnssec_records.test", "records.0.key_tag", "2371"),
+					resource.TestCheckResourceAttr("data.porkbun_dnssec_records.test", "records.1.key_tag", "64087"),
+					resource.TestCheckResourceAttr("data.porkbun_dnssec_records.test", "records.1.algorithm", "13"),
+				),
+			},
+			// Test a domain without DS records, for which Porkbun returns an empty array instead of an object.
+			{
+				Config: providerConfig + `
+					data "porkbun_dnssec_records" "test" {
+						domain = "example.org"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("data.porkbun_dnssec_records.test", "records.#", "0"),
+			},
+		},
+	})
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DomainAutoRenewResource{}
+	_ resource.ResourceWithImportState = &DomainAutoRenewResource{}
+	_ resource.ResourceWithModifyPlan  = &DomainAutoRenewResource{}
+)
+
+type DomainAutoRenewResource struct {
+	client *porkbun.Client
+}
+
+func NewDomainAutoRenewResource() resource.Resource {
+	return &DomainAutoRenewResource{}
+}
+
+func (r *DomainAutoRenewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auto_renew"
+}
+
+func (r *DomainAutoRenewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage whether your domain is renewed automatically. " +
+			"Destroying this resource leaves the auto-renew setting of the domain as it is.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the domain.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is renewed automatically.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+type DomainAutoRenewResourceModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *DomainAutoRenewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DomainAutoRenewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+}
+
+func (r *DomainAutoRenewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainAutoRenewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateAutoRenew(ctx, data.Enabled.ValueBool(), data.Domain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to update auto-renew", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainAutoRenewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainAutoRenewResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.client.GetDomain(ctx, data.Domain.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// The domain has expired or been transferred away.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get domain", err)
+		return
+	}
+
+	data.Enabled = types.BoolValue(bool(domain.AutoRenew))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainAutoRenewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DomainAutoRenewResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateAutoRenew(ctx, data.Enabled.ValueBool(), data.Domain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to update auto-renew", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainAutoRenewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Auto-renew is left as it is, turning it off on destroy could let a domain expire by accident.
+	resp.Diagnostics.AddWarning(
+		"Auto-renew left unchanged",
+		"The auto-renew setting of the domain has been removed from Terraform state but has not been changed at Porkbun.",
+	)
+}
+
+func (r *DomainAutoRenewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
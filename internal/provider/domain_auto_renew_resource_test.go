@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDomainAutoRenewResource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDomains([]porkbun.Domain{
+		{Domain: "example.com", Status: "ACTIVE", TLD: "com", AutoRenew: false},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test create and read.
+			{
+				Config: providerConfig + `
+					resource "porkbun_domain_auto_renew" "test" {
+						domain  = "example.com"
+						enabled = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_domain_auto_renew.test", "domain", "example.com"),
+					resource.TestCheckResourceAttr("porkbun_domain_auto_renew.test", "enabled", "true"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:                         "porkbun_domain_auto_renew.test",
+				ImportStateId:                        "example.com",
+				ImportStateVerifyIdentifierAttribute: "domain",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+			// Test drift detection, auto-renew turned off outside of Terraform is planned to be turned back on.
+			{
+				PreConfig: func() {
+					mockbun.SetDomains([]porkbun.Domain{
+						{Domain: "example.com", Status: "ACTIVE", TLD: "com", AutoRenew: false},
+					})
+				},
+				Config: providerConfig + `
+					resource "porkbun_domain_auto_renew" "test" {
+						domain  = "example.com"
+						enabled = true
+					}
+				`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestDomainAutoRenewResourceDomainRemovedOutOfBand(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDomains([]porkbun.Domain{
+		{Domain: "example.com", Status: "ACTIVE", TLD: "com", AutoRenew: false},
+	})
+	config := providerConfig + `
+		resource "porkbun_domain_auto_renew" "test" {
+			domain  = "example.com"
+			enabled = true
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a domain no longer in the account is planned to be re-created instead of failing.
+			{
+				PreConfig: func() {
+					mockbun.RemoveDomain("example.com")
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
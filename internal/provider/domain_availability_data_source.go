@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DomainAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &DomainAvailabilityDataSource{}
+)
+
+type DomainAvailabilityDataSource struct {
+	client *porkbun.Client
+}
+
+func NewDomainAvailabilityDataSource() datasource.DataSource {
+	return &DomainAvailabilityDataSource{}
+}
+
+func (d *DomainAvailabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_availability"
+}
+
+func (d *DomainAvailabilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Check whether a domain is available for registration and what it costs. All prices are in USD.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the domain to check.",
+				Required:            true,
+			},
+			"available": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is available for registration.",
+				Computed:            true,
+			},
+			"premium": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is a premium domain.",
+				Computed:            true,
+			},
+			"first_year_promo": schema.BoolAttribute{
+				MarkdownDescription: "Whether `price` is a promotional price for the first year only.",
+				Computed:            true,
+			},
+			"price": schema.Float64Attribute{
+				MarkdownDescription: "The price to register the domain for a year.",
+				Computed:            true,
+			},
+			"regular_price": schema.Float64Attribute{
+				MarkdownDescription: "The price to register the domain for a year without promotions.",
+				Computed:            true,
+			},
+			"renewal_price": schema.Float64Attribute{
+				MarkdownDescription: "The price to renew the domain for a year.",
+				Computed:            true,
+			},
+			"transfer_price": schema.Float64Attribute{
+				MarkdownDescription: "The price to transfer the domain.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type DomainAvailabilityDataSourceModel struct {
+	Domain         types.String  `tfsdk:"domain"`
+	Available      types.Bool    `tfsdk:"available"`
+	Premium        types.Bool    `tfsdk:"premium"`
+	FirstYearPromo types.Bool    `tfsdk:"first_year_promo"`
+	Price          types.Float64 `tfsdk:"price"`
+	RegularPrice   types.Float64 `tfsdk:"regular_price"`
+	RenewalPrice   types.Float64 `tfsdk:"renewal_price"`
+	TransferPrice  types.Float64 `tfsdk:"transfer_price"`
+}
+
+func (d *DomainAvailabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DomainAvailabilityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	availability, err := d.client.CheckDomain(ctx, state.Domain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to check domain", err)
+		return
+	}
+
+	state.Available = types.BoolValue(bool(availability.Available))
+	state.Premium = types.BoolValue(bool(availability.Premium))
+	state.FirstYearPromo = types.BoolValue(bool(availability.FirstYearPromo))
+	state.Price = parsePrice(availability.Price)
+	state.RegularPrice = parsePrice(availability.RegularPrice)
+	state.RenewalPrice = parsePrice(availability.Additional.Renewal.Price)
+	state.TransferPrice = parsePrice(availability.Additional.Transfer.Price)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
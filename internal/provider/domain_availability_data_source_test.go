@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDomainAvailabilityDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+
+	availability := porkbun.DomainAvailability{
+		Available:    true,
+		Type:         "registration",
+		Price:        "9.68",
+		RegularPrice: "9.68",
+	}
+	availability.Additional.Renewal = porkbun.DomainPrice{Type: "renewal", Price: "10.37"}
+	availability.Additional.Transfer = porkbun.DomainPrice{Type: "transfer", Price: "9.68"}
+	mockbun.SetDomainAvailability("example.com", availability)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "porkbun_domain_availability" "test" {
+						domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_domain_availability.test", "available", "true"),
+					resource.TestCheckResourceAttr("data.porkbun_domain_availability.test", "premium", "false"),
+					resource.TestCheckResourceAttr("data.porkbun_domain_availability.test", "price", "9.68"),
+					resource.TestCheckResourceAttr("data.porkbun_domain_availability.test", "renewal_price", "10.37"),
+				),
+			},
+		},
+	})
+}
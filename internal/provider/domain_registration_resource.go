@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &DomainRegistrationResource{}
+	_ resource.ResourceWithImportState = &DomainRegistrationResource{}
+	_ resource.ResourceWithModifyPlan  = &DomainRegistrationResource{}
+)
+
+type DomainRegistrationResource struct {
+	client *porkbun.Client
+}
+
+func NewDomainRegistrationResource() resource.Resource {
+	return &DomainRegistrationResource{}
+}
+
+func (r *DomainRegistrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_registration"
+}
+
+func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Register a new domain, agreeing to Porkbun's terms of service. " +
+			"Registration is aborted if the price Porkbun quotes exceeds `max_price`. " +
+			"Destroying this resource only removes the domain from Terraform state, the domain itself is never deleted.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the domain to register.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_price": schema.Float64Attribute{
+				MarkdownDescription: "The maximum price in USD you're willing to pay for registering the domain for a year.",
+				Required:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"price": schema.Float64Attribute{
+				MarkdownDescription: "The price in USD paid for registering the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"order_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the registration order.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the domain, e.g. `ACTIVE`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_date": schema.StringAttribute{
+				MarkdownDescription: "The date the domain was registered, as returned by Porkbun.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expire_date": schema.StringAttribute{
+				MarkdownDescription: "The date the domain expires, as returned by Porkbun.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type DomainRegistrationResourceModel struct {
+	Domain     types.String  `tfsdk:"domain"`
+	MaxPrice   types.Float64 `tfsdk:"max_price"`
+	Price      types.Float64 `tfsdk:"price"`
+	OrderID    types.String  `tfsdk:"order_id"`
+	Status     types.String  `tfsdk:"status"`
+	CreateDate types.String  `tfsdk:"create_date"`
+	ExpireDate types.String  `tfsdk:"expire_date"`
+}
+
+func (r *DomainRegistrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *DomainRegistrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+}
+
+func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainRegistrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	availability, err := r.client.CheckDomain(ctx, domain)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to check domain", err)
+		return
+	}
+
+	if !availability.Available {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain"),
+			"Domain is not available",
+			fmt.Sprintf("%s is not available for registration. "+
+				"If it is already in your account, import it instead.", domain),
+		)
+		return
+	}
+
+	price := parsePrice(availability.Price)
+	if price.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to check domain",
+			fmt.Sprintf("Porkbun returned an invalid price %q for %s.", availability.Price, domain),
+		)
+		return
+	}
+
+	if price.ValueFloat64() > data.MaxPrice.ValueFloat64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_price"),
+			"Domain price exceeds max_price",
+			fmt.Sprintf("Registering %s costs $%.2f, which exceeds the configured max_price of $%.2f. "+
+				"No registration has been attempted.", domain, price.ValueFloat64(), data.MaxPrice.ValueFloat64()),
+		)
+		return
+	}
+
+	orderID, err := r.client.CreateDomain(ctx, domain, int(math.Round(price.ValueFloat64()*100)))
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to register domain", err)
+		return
+	}
+
+	// The domain is paid for, so it has to end up in state even if looking it up fails.
+	data.Price = price
+	data.OrderID = types.StringValue(orderID)
+	data.Status = types.StringNull()
+	data.CreateDate = types.StringNull()
+	data.ExpireDate = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Registration may take a while to show up in the domain list, the next refresh fills these in then.
+	registered, err := r.client.GetDomain(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to get registered domain",
+			fmt.Sprintf("%s has been registered with order %s, but looking it up failed: %s", domain, orderID, err),
+		)
+		return
+	}
+
+	data.Status = types.StringValue(registered.Status)
+	data.CreateDate = types.StringValue(registered.CreateDate)
+	data.ExpireDate = types.StringValue(registered.ExpireDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRegistrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainRegistrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.client.GetDomain(ctx, data.Domain.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// Keep the domain in state, as it may just not be listed yet right after registering it. Dropping it would
+		// plan to register and pay for it again.
+		resp.Diagnostics.AddWarning(
+			"Registered domain not found",
+			fmt.Sprintf("%s is not in the domain list of your Porkbun account. If it has just been registered, "+
+				"it will show up once Porkbun is done with the registration. If it has expired or been transferred away, "+
+				"remove it from Terraform state with terraform state rm.", data.Domain.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get domain", err)
+		return
+	}
+
+	data.Status = types.StringValue(domain.Status)
+	data.CreateDate = types.StringValue(domain.CreateDate)
+	data.ExpireDate = types.StringValue(domain.ExpireDate)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRegistrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only max_price can change without replacement, and it only matters when registering, so nothing is sent to
+	// Porkbun and every computed attribute is kept as it is in state.
+	var data, state DomainRegistrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Price = state.Price
+	data.OrderID = state.OrderID
+	data.Status = state.Status
+	data.CreateDate = state.CreateDate
+	data.ExpireDate = state.ExpireDate
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRegistrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainRegistrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Domain not deleted",
+		fmt.Sprintf("%s has been removed from Terraform state but remains registered in your Porkbun account.", data.Domain.ValueString()),
+	)
+}
+
+func (r *DomainRegistrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
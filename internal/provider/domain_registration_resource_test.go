@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDomainRegistrationResource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDomainAvailability("example.com", porkbun.DomainAvailability{
+		Available: true,
+		Type:      "registration",
+		Price:     "9.73",
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that registration is aborted when the price exceeds max_price.
+			{
+				Config: providerConfig + `
+					resource "porkbun_domain_registration" "test" {
+						domain    = "example.com"
+						max_price = 5
+					}
+				`,
+				ExpectError: regexp.MustCompile("Domain price exceeds max_price"),
+			},
+			// Test create and read.
+			{
+				Config: providerConfig + `
+					resource "porkbun_domain_registration" "test" {
+						domain    = "example.com"
+						max_price = 10
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_domain_registration.test", "domain", "example.com"),
+					resource.TestCheckResourceAttr("porkbun_domain_registration.test", "price", "9.73"),
+					resource.TestCheckResourceAttr("porkbun_domain_registration.test", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet("porkbun_domain_registration.test", "order_id"),
+				),
+			},
+			// Test update of max_price doesn't re-register.
+			{
+				Config: providerConfig + `
+					resource "porkbun_domain_registration" "test" {
+						domain    = "example.com"
+						max_price = 20
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_domain_registration.test", "max_price", "20"),
+					resource.TestCheckResourceAttr("porkbun_domain_registration.test", "price", "9.73"),
+				),
+			},
+		},
+	})
+}
+
+func TestDomainRegistrationResourceNotListed(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetDomainAvailability("example.com", porkbun.DomainAvailability{
+		Available: true,
+		Type:      "registration",
+		Price:     "9.73",
+	})
+	config := providerConfig + `
+		resource "porkbun_domain_registration" "test" {
+			domain    = "example.com"
+			max_price = 10
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a domain missing from the domain list, e.g. right after registering it, is kept in state
+			// instead of being planned to be registered again.
+			{
+				PreConfig: func() {
+					mockbun.RemoveDomain("example.com")
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &DomainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &DomainsDataSource{}
+)
+
+type DomainsDataSource struct {
+	client *porkbun.Client
+}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+func (d *DomainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get all domains in your account.",
+		Attributes: map[string]schema.Attribute{
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "The domains in the account.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The FQDN of the domain.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the domain, e.g. `ACTIVE`.",
+							Computed:            true,
+						},
+						"tld": schema.StringAttribute{
+							MarkdownDescription: "The top-level domain of the domain.",
+							Computed:            true,
+						},
+						"create_date": schema.StringAttribute{
+							MarkdownDescription: "The date the domain was registered, as returned by Porkbun.",
+							Computed:            true,
+						},
+						"expire_date": schema.StringAttribute{
+							MarkdownDescription: "The date the domain expires, as returned by Porkbun.",
+							Computed:            true,
+						},
+						"auto_renew": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain is renewed automatically.",
+							Computed:            true,
+						},
+						"locked": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain has a security lock against transfers.",
+							Computed:            true,
+						},
+						"whois_privacy": schema.BoolAttribute{
+							MarkdownDescription: "Whether WHOIS privacy is enabled for the domain.",
+							Computed:            true,
+						},
+						"labels": schema.ListAttribute{
+							MarkdownDescription: "The titles of the labels assigned to the domain.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type DomainsDataSourceModel struct {
+	Domains []DomainsDataSourceDomainModel `tfsdk:"domains"`
+}
+
+type DomainsDataSourceDomainModel struct {
+	Domain       types.String   `tfsdk:"domain"`
+	Status       types.String   `tfsdk:"status"`
+	TLD          types.String   `tfsdk:"tld"`
+	CreateDate   types.String   `tfsdk:"create_date"`
+	ExpireDate   types.String   `tfsdk:"expire_date"`
+	AutoRenew    types.Bool     `tfsdk:"auto_renew"`
+	Locked       types.Bool     `tfsdk:"locked"`
+	WhoisPrivacy types.Bool     `tfsdk:"whois_privacy"`
+	Labels       []types.String `tfsdk:"labels"`
+}
+
+func (d *DomainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state DomainsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := d.client.ListDomains(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to list domains", err)
+		return
+	}
+
+	tfDomains := make([]DomainsDataSourceDomainModel, len(domains))
+	for i, domain := range domains {
+		labels := make([]types.String, len(domain.Labels))
+		for j, label := range domain.Labels {
+			labels[j] = types.StringValue(label.Title)
+		}
+
+		tfDomains[i] = DomainsDataSourceDomainModel{
+			Domain:       types.StringValue(domain.Domain),
+			Status:       types.StringValue(domain.Status),
+			TLD:          types.StringValue(domain.TLD),
+			CreateDate:   types.StringValue(domain.CreateDate),
+			ExpireDate:   types.StringValue(domain.ExpireDate),
+			AutoRenew:    types.BoolValue(bool(domain.AutoRenew)),
+			Locked:       types.BoolValue(bool(domain.SecurityLock)),
+			WhoisPrivacy: types.BoolValue(bool(domain.WhoisPrivacy)),
+			Labels:       labels,
+		}
+	}
+	state.Domains = tfDomains
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
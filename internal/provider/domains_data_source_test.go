@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestDomainsDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+
+	// More than a single page of domains to test pagination.
+	domains := make([]porkbun.Domain, 1500)
+	for i := range domains {
+		domains[i] = porkbun.Domain{
+			Domain:     fmt.Sprintf("example%d.com", i),
+			Status:     "ACTIVE",
+			TLD:        "com",
+			CreateDate: "2020-01-01 00:00:00",
+			ExpireDate: "2030-01-01 00:00:00",
+		}
+	}
+	domains[0].AutoRenew = true
+	domains[0].SecurityLock = true
+	domains[0].Labels = []porkbun.DomainLabel{{ID: "1", Title: "production", Color: "#ff0000"}}
+	mockbun.SetDomains(domains)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "porkbun_domains" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.#", "1500"),
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.0.domain", "example0.com"),
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.0.auto_renew", "true"),
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.0.locked", "true"),
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.0.whois_privacy", "false"),
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.0.labels.0", "production"),
+					resource.TestCheckResourceAttr("data.porkbun_domains.test", "domains.1499.domain", "example1499.com"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &GlueRecordResource{}
+	_ resource.ResourceWithImportState = &GlueRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &GlueRecordResource{}
+)
+
+type GlueRecordResource struct {
+	client *porkbun.Client
+}
+
+func NewGlueRecordResource() resource.Resource {
+	return &GlueRecordResource{}
+}
+
+func (r *GlueRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glue_record"
+}
+
+func (r *GlueRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Create glue records for nameservers hosted under your domain, e.g. `ns1.example.com`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The host name of the glue record.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the glue record.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "The subdomain of the nameserver host, not including the domain itself.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ips": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IPv4 and IPv6 addresses of the nameserver host.",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(isIPAddress()),
+				},
+			},
+		},
+	}
+}
+
+type GlueRecordResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Domain    types.String   `tfsdk:"domain"`
+	Subdomain types.String   `tfsdk:"subdomain"`
+	IPs       []types.String `tfsdk:"ips"`
+}
+
+func (m *GlueRecordResourceModel) ips() []string {
+	ips := make([]string, len(m.IPs))
+	for i, ip := range m.IPs {
+		ips[i] = ip.ValueString()
+	}
+
+	return ips
+}
+
+func (r *GlueRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *GlueRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+}
+
+func (r *GlueRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GlueRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateGlueRecord(ctx, data.Domain.ValueString(), data.Subdomain.ValueString(), data.ips())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to create glue record", err)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s", data.Subdomain.ValueString(), data.Domain.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GlueRecordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := r.client.GetGlueRecords(ctx, data.Domain.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// The domain is no longer in the account.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get glue records", err)
+		return
+	}
+
+	host := fmt.Sprintf("%s.%s", data.Subdomain.ValueString(), data.Domain.ValueString())
+
+	var record *porkbun.GlueRecord
+	for i := range records {
+		if records[i].Host == host {
+			record = &records[i]
+			break
+		}
+	}
+
+	if record == nil {
+		// The glue record has been deleted outside of Terraform, plan to recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ips := make([]types.String, 0, len(record.IPv4)+len(record.IPv6))
+	for _, ip := range append(record.IPv4, record.IPv6...) {
+		ips = append(ips, types.StringValue(ip))
+	}
+
+	data.ID = types.StringValue(host)
+	data.IPs = ips
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GlueRecordResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateGlueRecord(ctx, data.Domain.ValueString(), data.Subdomain.ValueString(), data.ips())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to update glue record", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GlueRecordResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGlueRecord(ctx, data.Domain.ValueString(), data.Subdomain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to delete glue record", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := strings.SplitN(req.ID, "/", 2)
+
+	var domain string
+	var subdomain string
+	if len(importId) == 2 {
+		domain, subdomain = importId[0], importId[1]
+	} else {
+		resp.Diagnostics.AddError(
+			"Invalid import ID specified",
+			"Use the import ID of format \"FQDN/subdomain\" to import glue records.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subdomain"), subdomain)...)
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGlueRecordResource(t *testing.T) {
+	providerConfig, _ := getProviderConfigWithMockServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test validation.
+			{
+				Config: providerConfig + `
+					resource "porkbun_glue_record" "test" {
+						domain    = "example.com"
+						subdomain = "ns1"
+						ips       = ["not-an-ip"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid IP address"),
+			},
+			// Test create and read.
+			{
+				Config: providerConfig + `
+					resource "porkbun_glue_record" "test" {
+						domain    = "example.com"
+						subdomain = "ns1"
+						ips       = ["192.0.2.1"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_glue_record.test", "id", "ns1.example.com"),
+					resource.TestCheckResourceAttr("porkbun_glue_record.test", "ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("porkbun_glue_record.test", "ips.*", "192.0.2.1"),
+				),
+			},
+			// Test update in place.
+			{
+				Config: providerConfig + `
+					resource "porkbun_glue_record" "test" {
+						domain    = "example.com"
+						subdomain = "ns1"
+						ips       = ["192.0.2.1", "2001:db8::1"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_glue_record.test", "ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr("porkbun_glue_record.test", "ips.*", "2001:db8::1"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName:      "porkbun_glue_record.test",
+				ImportStateId:     "example.com/ns1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGlueRecordResourceDeletedOutOfBand(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	config := providerConfig + `
+		resource "porkbun_glue_record" "test" {
+			domain    = "example.com"
+			subdomain = "ns1"
+			ips       = ["192.0.2.1"]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a glue record deleted outside of Terraform is planned to be re-created instead of failing.
+			{
+				PreConfig: func() {
+					mockbun.SetGlueRecords("example.com", map[string][]string{})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -57,22 +57,23 @@ func (d *NameserversDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*porkbun.Client)
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			consts.ErrUnexpectedDataSourceConfigureType,
-			fmt.Sprintf("Expected *porkbun.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.Client
 }
 
 func (d *NameserversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state NameserversDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	checkAllowedDomain(d.client, state.Domain, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -80,7 +81,7 @@ func (d *NameserversDataSource) Read(ctx context.Context, req datasource.ReadReq
 	domain := state.Domain.ValueString()
 	nameservers, err := d.client.GetNameservers(ctx, domain)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to get nameservers", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to get nameservers", err)
 		return
 	}
 
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +17,7 @@ import (
 var (
 	_ resource.Resource                = &NameserversResource{}
 	_ resource.ResourceWithImportState = &NameserversResource{}
+	_ resource.ResourceWithModifyPlan  = &NameserversResource{}
 )
 
 type NameserversResource struct {
@@ -58,16 +60,20 @@ func (r *NameserversResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*porkbun.Client)
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			consts.ErrUnexpectedResourceConfigureType,
-			fmt.Sprintf("Expected *porkbun.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+}
+
+func (r *NameserversResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
 }
 
 func (r *NameserversResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -85,7 +91,7 @@ func (r *NameserversResource) Create(ctx context.Context, req resource.CreateReq
 
 	err := r.client.UpdateNameservers(ctx, data.Domain.ValueString(), nameservers)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update nameservers", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to update nameservers", err)
 		return
 	}
 
@@ -101,8 +107,13 @@ func (r *NameserversResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	nameservers, err := r.client.GetNameservers(ctx, data.Domain.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// The domain is no longer in the account, e.g. it has expired or been transferred away.
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to get nameservers", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to get nameservers", err)
 		return
 	}
 
@@ -130,7 +141,7 @@ func (r *NameserversResource) Update(ctx context.Context, req resource.UpdateReq
 
 	err := r.client.UpdateNameservers(ctx, data.Domain.ValueString(), nameservers)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update nameservers", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to update nameservers", err)
 		return
 	}
 
@@ -147,7 +158,7 @@ func (r *NameserversResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	err := r.client.UpdateNameservers(ctx, data.Domain.ValueString(), consts.GetDefaultNameservers())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update nameservers", err.Error())
+		addDomainAPIError(&resp.Diagnostics, "Unable to update nameservers", err)
 		return
 	}
 
@@ -41,3 +41,42 @@ func TestNameserversResource(t *testing.T) {
 		},
 	})
 }
+
+func TestNameserversResourceDomainRemovedOutOfBand(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetNameservers("example.com", []string{})
+	config := providerConfig + `
+		resource "porkbun_nameservers" "test" {
+			domain      = "example.com"
+			nameservers = ["evan.ns.cloudflare.com"]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a domain no longer in the account is planned to be re-created instead of failing.
+			{
+				PreConfig: func() {
+					mockbun.RemoveDomain("example.com")
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Test re-create once the domain is back.
+			{
+				PreConfig: func() {
+					mockbun.SetNameservers("example.com", []string{})
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("porkbun_nameservers.test", "nameservers.0", "evan.ns.cloudflare.com"),
+				),
+			},
+		},
+	})
+}
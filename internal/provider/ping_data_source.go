@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &PingDataSource{}
+	_ datasource.DataSourceWithConfigure = &PingDataSource{}
+)
+
+type PingDataSource struct {
+	client *porkbun.Client
+}
+
+func NewPingDataSource() datasource.DataSource {
+	return &PingDataSource{}
+}
+
+func (d *PingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ping"
+}
+
+func (d *PingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Check that the configured API keys work and get the IP address requests to Porkbun come from.",
+		Attributes: map[string]schema.Attribute{
+			"your_ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address Porkbun sees requests coming from.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+type PingDataSourceModel struct {
+	YourIP types.String `tfsdk:"your_ip"`
+}
+
+func (d *PingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *PingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	yourIP, err := d.client.Ping(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to ping Porkbun", err)
+		return
+	}
+
+	state := PingDataSourceModel{
+		YourIP: types.StringValue(yourIP),
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -2,17 +2,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
 	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
 )
@@ -20,6 +29,13 @@ import (
 // Ensure the implementation satisfies the expected interfaces.
 var _ provider.Provider = &PorkbunProvider{}
 
+// PorkbunProviderData is handed to resources and data sources when they're configured.
+type PorkbunProviderData struct {
+	Client *porkbun.Client
+	// DefaultDomain is used by resources whose domain isn't configured, empty if not set.
+	DefaultDomain string
+}
+
 type PorkbunProvider struct {
 	// version is set to the provider version on release, "dev" when the
 	// provider is built and ran locally, and "test" when running acceptance
@@ -42,6 +58,11 @@ func (p *PorkbunProvider) Metadata(ctx context.Context, req provider.MetadataReq
 
 func (p *PorkbunProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Each API key is taken from the first source that provides it: " +
+			"the `api_key`/`secret_api_key` attributes, the `api_key_file`/`secret_api_key_file` attributes, " +
+			"the `PORKBUN_API_KEY`/`PORKBUN_SECRET_API_KEY` environment variables, " +
+			"the `PORKBUN_API_KEY_FILE`/`PORKBUN_SECRET_API_KEY_FILE` environment variables, " +
+			"the `credentials_process` command, and finally the `profile` of the `shared_credentials_file`.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "`apikey` required by Porkbun API. " +
@@ -55,6 +76,36 @@ func (p *PorkbunProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Sensitive: true,
 				Optional:  true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the `apikey`, e.g. a mounted secret. Surrounding whitespace is ignored. " +
+					"Used when `api_key` is not set. " +
+					"Can also be configured using the `PORKBUN_API_KEY_FILE` environment variable.",
+				Optional: true,
+			},
+			"secret_api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the `secretapikey`, e.g. a mounted secret. Surrounding whitespace is ignored. " +
+					"Used when `secret_api_key` is not set. " +
+					"Can also be configured using the `PORKBUN_SECRET_API_KEY_FILE` environment variable.",
+				Optional: true,
+			},
+			"credentials_process": schema.StringAttribute{
+				MarkdownDescription: "Command run by the shell (`sh -c`, or `cmd.exe /C` on Windows) to obtain the API keys, " +
+					"used for keys not found in the configuration, files or environment variables. " +
+					"The command must print `{\"api_key\": \"...\", \"secret_api_key\": \"...\"}` to stdout. " +
+					"Can also be configured using the `PORKBUN_CREDENTIALS_PROCESS` environment variable.",
+				Optional: true,
+			},
+			"shared_credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the shared credentials file (default to `~/.config/porkbun/credentials`), " +
+					"consulted last for keys not found elsewhere. " +
+					"Can also be configured using the `PORKBUN_SHARED_CREDENTIALS_FILE` environment variable.",
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile of the shared credentials file to read the API keys from (default to `default`). " +
+					"Can also be configured using the `PORKBUN_PROFILE` environment variable.",
+				Optional: true,
+			},
 			"custom_base_url": schema.StringAttribute{
 				MarkdownDescription: "Override the default base URL (https://porkbun.com/api/json/v3) used by Porkbun API client. " +
 					"Can also be configured using the `PORKBUN_CUSTOM_BASE_URL` environment variable.",
@@ -65,6 +116,84 @@ func (p *PorkbunProvider) Schema(ctx context.Context, req provider.SchemaRequest
 					"Can also be configured using the `PORKBUN_MAX_RETRIES` environment variable.",
 				Optional: true,
 			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of seconds to wait before retrying a failed API request (default to 1). " +
+					"Can also be configured using the `PORKBUN_RETRY_WAIT_MIN` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of seconds to wait before retrying a failed API request (default to 30). " +
+					"Can also be configured using the `PORKBUN_RETRY_WAIT_MAX` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds after which a single attempt of an API request times out (default to 10). " +
+					"Can also be configured using the `PORKBUN_REQUEST_TIMEOUT` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to send API requests through, e.g. `https://proxy.example.com:3128`. " +
+					"Defaults to the proxy set by the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables. " +
+					"Can also be configured using the `PORKBUN_HTTP_PROXY` environment variable.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded CA certificates to trust in addition to the system's, " +
+					"e.g. those of a TLS intercepting proxy. " +
+					"Can also be configured using the `PORKBUN_CA_CERT_FILE` environment variable.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second, shared by all resources and data sources, " +
+					"counting every retry (default to 5). Set to 0 to disable rate limiting. " +
+					"Can also be configured using the `PORKBUN_REQUESTS_PER_SECOND` environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of API requests in flight at once (default to 4). " +
+					"Set to 0 to allow any number of concurrent requests. " +
+					"Can also be configured using the `PORKBUN_MAX_CONCURRENT_REQUESTS` environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"default_domain": schema.StringAttribute{
+				MarkdownDescription: "Domain used by `porkbun_dns_record` resources that configure neither `domain` nor `fqdn`. " +
+					"Can also be configured using the `PORKBUN_DEFAULT_DOMAIN` environment variable.",
+				Optional: true,
+			},
+			"domains": schema.SetAttribute{
+				MarkdownDescription: "Domains of the account the API keys belong to. When set, resources and data sources fail to plan " +
+					"for any other domain, which catches resources pointed at the wrong provider alias when managing several accounts. " +
+					"Can also be configured using the `PORKBUN_DOMAINS` environment variable, as a comma-separated list.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Ping Porkbun when the provider is configured, failing early if the API keys don't work " +
+					"and warning which IP address requests come from (default to false). " +
+					"Can also be configured using the `PORKBUN_VALIDATE_CREDENTIALS` environment variable.",
+				Optional: true,
+			},
+			"zone_read_cache": schema.BoolAttribute{
+				MarkdownDescription: "List the DNS records of each domain only once per run and answer reads of single records from it, " +
+					"until a record of the domain is changed (default to false). " +
+					"Can also be configured using the `PORKBUN_ZONE_READ_CACHE` environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -74,6 +203,25 @@ type PorkbunProviderConfigurationModel struct {
 	SecretAPIKey  types.String `tfsdk:"secret_api_key"`
 	CustomBaseURL types.String `tfsdk:"custom_base_url"`
 	MaxRetries    types.Int64  `tfsdk:"max_retries"`
+
+	RetryWaitMin   types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax   types.Int64  `tfsdk:"retry_wait_max"`
+	RequestTimeout types.Int64  `tfsdk:"request_timeout"`
+	HTTPProxy      types.String `tfsdk:"http_proxy"`
+	CACertFile     types.String `tfsdk:"ca_cert_file"`
+
+	APIKeyFile            types.String `tfsdk:"api_key_file"`
+	SecretAPIKeyFile      types.String `tfsdk:"secret_api_key_file"`
+	CredentialsProcess    types.String `tfsdk:"credentials_process"`
+	SharedCredentialsFile types.String `tfsdk:"shared_credentials_file"`
+	Profile               types.String `tfsdk:"profile"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	ZoneReadCache         types.Bool    `tfsdk:"zone_read_cache"`
+	DefaultDomain         types.String  `tfsdk:"default_domain"`
+	Domains               types.Set     `tfsdk:"domains"`
+	ValidateCredentials   types.Bool    `tfsdk:"validate_credentials"`
 }
 
 func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -87,60 +235,51 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	// Validate that none of the configuration values are unknown.
-	if config.APIKey.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("api_key"),
-			consts.ErrUnknownConfigurationValue,
-			`The provider cannot create Porkbun API client as there is an unknown configuration value for "api_key". `+
-				"Either target apply the source of the value first, set the value statically in the configuration, "+
-				"or use the PORKBUN_API_KEY environment variable.",
-		)
-	}
-	if config.SecretAPIKey.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("secret_api_key"),
-			consts.ErrUnknownConfigurationValue,
-			`The provider cannot create Porkbun API client as there is an unknown configuration value for "secret_api_key". `+
-				"Either target apply the source of the value first, set the value statically in the configuration, "+
-				"or use the PORKBUN_SECRET_API_KEY environment variable.",
-		)
-	}
-	if config.CustomBaseURL.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("custom_base_url"),
-			consts.ErrUnknownConfigurationValue,
-			`The provider cannot create Porkbun API client as there is an unknown configuration value for "custom_base_url". `+
-				"Either target apply the source of the value first, set the value statically in the configuration, "+
-				"or use the PORKBUN_CUSTOM_BASE_URL environment variable.",
-		)
-	}
-	if config.MaxRetries.IsUnknown() {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("max_retries"),
-			consts.ErrUnknownConfigurationValue,
-			`The provider cannot create Porkbun API client as there is an unknown configuration value for "max_retries". `+
-				"Either target apply the source of the value first, set the value statically in the configuration, "+
-				"or use the PORKBUN_MAX_RETRIES environment variable.",
-		)
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"api_key", config.APIKey},
+		{"secret_api_key", config.SecretAPIKey},
+		{"api_key_file", config.APIKeyFile},
+		{"secret_api_key_file", config.SecretAPIKeyFile},
+		{"credentials_process", config.CredentialsProcess},
+		{"shared_credentials_file", config.SharedCredentialsFile},
+		{"profile", config.Profile},
+		{"custom_base_url", config.CustomBaseURL},
+		{"max_retries", config.MaxRetries},
+		{"retry_wait_min", config.RetryWaitMin},
+		{"retry_wait_max", config.RetryWaitMax},
+		{"request_timeout", config.RequestTimeout},
+		{"http_proxy", config.HTTPProxy},
+		{"ca_cert_file", config.CACertFile},
+		{"requests_per_second", config.RequestsPerSecond},
+		{"max_concurrent_requests", config.MaxConcurrentRequests},
+		{"zone_read_cache", config.ZoneReadCache},
+		{"default_domain", config.DefaultDomain},
+		{"domains", config.Domains},
+		{"validate_credentials", config.ValidateCredentials},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				consts.ErrUnknownConfigurationValue,
+				fmt.Sprintf(`The provider cannot create Porkbun API client as there is an unknown configuration value for %q. `+
+					"Either target apply the source of the value first, set the value statically in the configuration, "+
+					"or use the %s environment variable.", attribute.name, envName(attribute.name)),
+			)
+		}
 	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Load configuration values from either terraform files or environment variables.
-	var apiKey string
-	if config.APIKey.IsNull() {
-		apiKey = os.Getenv("PORKBUN_API_KEY")
-	} else {
-		apiKey = config.APIKey.ValueString()
-	}
-
-	var secretAPIKey string
-	if config.SecretAPIKey.IsNull() {
-		secretAPIKey = os.Getenv("PORKBUN_SECRET_API_KEY")
-	} else {
-		secretAPIKey = config.SecretAPIKey.ValueString()
+	creds := loadCredentials(ctx, config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	apiKey, secretAPIKey := creds.APIKey, creds.SecretAPIKey
 
 	var customBaseURL string
 	if config.CustomBaseURL.IsNull() {
@@ -151,21 +290,101 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	var maxRetries int64 = 4
 	if config.MaxRetries.IsNull() {
-		if value, ok := os.LookupEnv("PORKBUN_MAX_RETRIES"); ok {
-			maxRetries, _ = strconv.ParseInt(value, 10, 64)
-		}
+		maxRetries = lookupEnvInt64("max_retries", maxRetries, 0, &resp.Diagnostics)
 	} else {
 		maxRetries = config.MaxRetries.ValueInt64()
 	}
 
+	var retryWaitMin int64 = 1
+	if config.RetryWaitMin.IsNull() {
+		retryWaitMin = lookupEnvInt64("retry_wait_min", retryWaitMin, 0, &resp.Diagnostics)
+	} else {
+		retryWaitMin = config.RetryWaitMin.ValueInt64()
+	}
+
+	var retryWaitMax int64 = 30
+	if config.RetryWaitMax.IsNull() {
+		retryWaitMax = lookupEnvInt64("retry_wait_max", retryWaitMax, 0, &resp.Diagnostics)
+	} else {
+		retryWaitMax = config.RetryWaitMax.ValueInt64()
+	}
+
+	var requestTimeout int64 = 10
+	if config.RequestTimeout.IsNull() {
+		requestTimeout = lookupEnvInt64("request_timeout", requestTimeout, 1, &resp.Diagnostics)
+	} else {
+		requestTimeout = config.RequestTimeout.ValueInt64()
+	}
+
+	var httpProxy string
+	if config.HTTPProxy.IsNull() {
+		httpProxy = os.Getenv("PORKBUN_HTTP_PROXY")
+	} else {
+		httpProxy = config.HTTPProxy.ValueString()
+	}
+
+	var caCertFile string
+	if config.CACertFile.IsNull() {
+		caCertFile = os.Getenv("PORKBUN_CA_CERT_FILE")
+	} else {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	var requestsPerSecond float64 = 5
+	if config.RequestsPerSecond.IsNull() {
+		requestsPerSecond = lookupEnvFloat64("requests_per_second", requestsPerSecond, 0, &resp.Diagnostics)
+	} else {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+
+	var maxConcurrentRequests int64 = 4
+	if config.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = lookupEnvInt64("max_concurrent_requests", maxConcurrentRequests, 0, &resp.Diagnostics)
+	} else {
+		maxConcurrentRequests = config.MaxConcurrentRequests.ValueInt64()
+	}
+
+	var zoneReadCache bool
+	if config.ZoneReadCache.IsNull() {
+		zoneReadCache = lookupEnvBool("zone_read_cache", zoneReadCache, &resp.Diagnostics)
+	} else {
+		zoneReadCache = config.ZoneReadCache.ValueBool()
+	}
+
+	var validateCredentials bool
+	if config.ValidateCredentials.IsNull() {
+		validateCredentials = lookupEnvBool("validate_credentials", validateCredentials, &resp.Diagnostics)
+	} else {
+		validateCredentials = config.ValidateCredentials.ValueBool()
+	}
+
+	var defaultDomain string
+	if config.DefaultDomain.IsNull() {
+		defaultDomain = os.Getenv("PORKBUN_DEFAULT_DOMAIN")
+	} else {
+		defaultDomain = config.DefaultDomain.ValueString()
+	}
+
+	var domains []string
+	if config.Domains.IsNull() {
+		if value := os.Getenv("PORKBUN_DOMAINS"); value != "" {
+			for _, domain := range strings.Split(value, ",") {
+				domains = append(domains, strings.TrimSpace(domain))
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(config.Domains.ElementsAs(ctx, &domains, false)...)
+	}
+
 	// Validate that required values are populated.
 	if apiKey == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			consts.ErrInvalidConfigurationValue,
 			`The provider cannot create Porkbun API client as there is a missing or empty value for "api_key". `+
-				"Set the value in the configuration or use the PORKBUN_API_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the value in the configuration, use the PORKBUN_API_KEY environment variable, "+
+				"or provide it through \"api_key_file\", \"credentials_process\" or the shared credentials file. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 	if secretAPIKey == "" {
@@ -173,8 +392,9 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 			path.Root("secret_api_key"),
 			consts.ErrInvalidConfigurationValue,
 			`The provider cannot create Porkbun API client as there is a missing or empty value for "secret_api_key". `+
-				"Set the value in the configuration or use the PORKBUN_SECRET_API_KEY environment variable. "+
-				"If either is already set, ensure the value is not empty.",
+				"Set the value in the configuration, use the PORKBUN_SECRET_API_KEY environment variable, "+
+				"or provide it through \"secret_api_key_file\", \"credentials_process\" or the shared credentials file. "+
+				"If any is already set, ensure the value is not empty.",
 		)
 	}
 	if resp.Diagnostics.HasError() {
@@ -205,24 +425,165 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 		client.SetCustomBaseURL(urlParsed)
 	}
 
+	if retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			consts.ErrInvalidConfigurationValue,
+			fmt.Sprintf(`The provider cannot wait at least %d seconds between retries as "retry_wait_max" is %d seconds.`, retryWaitMin, retryWaitMax),
+		)
+		return
+	}
+
+	var proxyURL *url.URL
+	if httpProxy != "" {
+		var err error
+		proxyURL, err = url.Parse(httpProxy)
+		if err != nil || proxyURL.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_proxy"),
+				consts.ErrInvalidConfigurationValue,
+				`The provider cannot send requests through the proxy as the value configured for "http_proxy" is not a valid URL.`,
+			)
+			return
+		}
+	}
+
+	transport, err := newTransport(proxyURL, caCertFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_cert_file"),
+			consts.ErrInvalidConfigurationValue,
+			fmt.Sprintf(`The provider cannot load the CA certificates of "ca_cert_file" %q: %s`, caCertFile, err),
+		)
+		return
+	}
+
 	// Replace client's `httpClient` with `retryablehttp.Client`.
 	retryClient := retryablehttp.NewClient()
+	retryClient.HTTPClient.Transport = porkbun.NewRateLimitedTransport(transport, requestsPerSecond, int(maxConcurrentRequests))
+	retryClient.HTTPClient.Timeout = time.Duration(requestTimeout) * time.Second
 	retryClient.RetryMax = int(maxRetries)
+	retryClient.RetryWaitMin = time.Duration(retryWaitMin) * time.Second
+	retryClient.RetryWaitMax = time.Duration(retryWaitMax) * time.Second
+	retryClient.CheckRetry = retryPolicy
+	retryClient.Backoff = retryBackoff
+	// Hand the last response over to the client once retries are exhausted, so that it can report a typed error.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
 	client.SetCustomHTTPClient(retryClient.StandardClient())
+	client.SetUserAgent(userAgent(p.version, req.TerraformVersion))
+	if zoneReadCache {
+		client.EnableZoneReadCache()
+	}
+	client.SetAllowedDomains(domains)
+	if defaultDomain != "" && !client.AllowsDomain(defaultDomain) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_domain"),
+			consts.ErrInvalidConfigurationValue,
+			fmt.Sprintf(`The provider cannot use %q as "default_domain" as it is not one of the configured "domains".`, defaultDomain),
+		)
+		return
+	}
+
+	if validateCredentials {
+		yourIP, err := client.Ping(ctx)
+		if err != nil {
+			addAPIError(&resp.Diagnostics, "Unable to validate credentials", err)
+			return
+		}
 
-	resp.ResourceData = &client
-	resp.DataSourceData = &client
+		tflog.Info(ctx, "Validated Porkbun credentials", map[string]interface{}{"your_ip": yourIP})
+
+		// Keys restricted to other IP addresses are a common surprise, so tell which address requests come from.
+		resp.Diagnostics.AddWarning(
+			"Validated Porkbun credentials",
+			fmt.Sprintf("The API keys work for requests from IP address %s. "+
+				"If the keys are restricted by IP address, requests from other addresses will fail.", yourIP),
+		)
+	}
+
+	providerData := &PorkbunProviderData{
+		Client:        &client,
+		DefaultDomain: defaultDomain,
+	}
+	resp.ResourceData = providerData
+	resp.DataSourceData = providerData
 }
 
 func (p *PorkbunProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewNameserversResource,
 		NewDNSRecordResource,
+		NewDNSZoneResource,
+		NewDNSRecordSetResource,
+		NewURLForwardResource,
+		NewDNSSECRecordResource,
+		NewGlueRecordResource,
+		NewDomainAutoRenewResource,
+		NewDomainRegistrationResource,
 	}
 }
 
 func (p *PorkbunProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewNameserversDataSource,
+		NewDNSRecordsDataSource,
+		NewURLForwardsDataSource,
+		NewDNSSECRecordsDataSource,
+		NewSSLBundleDataSource,
+		NewDomainsDataSource,
+		NewDomainAvailabilityDataSource,
+		NewTLDPricingDataSource,
+		NewPingDataSource,
 	}
 }
+
+// envName returns the environment variable an attribute of the provider can also be configured with.
+func envName(attribute string) string {
+	return "PORKBUN_" + strings.ToUpper(attribute)
+}
+
+// lookupEnvInt64 and lookupEnvFloat64 also reject values below minimum, like the validators of the attribute would.
+func lookupEnvInt64(attribute string, value, minimum int64, diags *diag.Diagnostics) int64 {
+	return lookupEnv(attribute, value, func(s string) (int64, error) {
+		parsed, err := strconv.ParseInt(s, 10, 64)
+		if err == nil && parsed < minimum {
+			err = fmt.Errorf("value must be at least %d", minimum)
+		}
+		return parsed, err
+	}, diags)
+}
+
+func lookupEnvFloat64(attribute string, value, minimum float64, diags *diag.Diagnostics) float64 {
+	return lookupEnv(attribute, value, func(s string) (float64, error) {
+		parsed, err := strconv.ParseFloat(s, 64)
+		if err == nil && parsed < minimum {
+			err = fmt.Errorf("value must be at least %g", minimum)
+		}
+		return parsed, err
+	}, diags)
+}
+
+func lookupEnvBool(attribute string, value bool, diags *diag.Diagnostics) bool {
+	return lookupEnv(attribute, value, strconv.ParseBool, diags)
+}
+
+// lookupEnv parses the environment variable of attribute, returning value if the variable isn't set. A value that
+// can't be parsed is reported as an error instead of silently falling back to the zero value.
+func lookupEnv[T any](attribute string, value T, parse func(string) (T, error), diags *diag.Diagnostics) T {
+	env, ok := os.LookupEnv(envName(attribute))
+	if !ok {
+		return value
+	}
+
+	parsed, err := parse(env)
+	if err != nil {
+		diags.AddError(
+			consts.ErrInvalidConfigurationValue,
+			fmt.Sprintf("The provider cannot use %q of the %s environment variable as %q: %s",
+				env, envName(attribute), attribute, err),
+		)
+		return value
+	}
+
+	return parsed
+}
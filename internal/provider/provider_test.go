@@ -2,10 +2,15 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/kyswtn/terraform-provider-porkbun/internal/mockbun"
 )
 
@@ -33,3 +38,292 @@ func getProviderConfigWithMockServer(t *testing.T) (string, *mockbun.Server) {
 
 	return config, mockbunServer
 }
+
+// testCheckMockDNSRecords checks, regardless of order, that the mock server holds exactly the given records, each
+// formatted as "name type content".
+func testCheckMockDNSRecords(server *mockbun.Server, domain string, expected []string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		records := server.DNSRecords(domain)
+		if len(records) != len(expected) {
+			return fmt.Errorf("expected %d DNS records, got %d: %v", len(expected), len(records), records)
+		}
+
+		for _, e := range expected {
+			found := false
+			for _, r := range records {
+				if fmt.Sprintf("%s %s %s", r.Name, r.Type, r.Content) == e {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("expected DNS record %q not found in %v", e, records)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestProviderRateLimiting(t *testing.T) {
+	mockbunServer := mockbun.New()
+	t.Cleanup(mockbunServer.Close)
+	mockbunServer.SetRateLimited(3)
+
+	expected := make([]string, 10)
+	for i := range expected {
+		expected[i] = fmt.Sprintf("r%d.example.com A 1.2.3.4", i)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test that throttled requests are retried and that limits don't get in the way of parallel applies.
+			{
+				Config: fmt.Sprintf(`
+					provider "porkbun" {
+						api_key                 = "apikey"
+						secret_api_key          = "secretapikey"
+						custom_base_url         = "%s"
+						requests_per_second     = 20
+						max_concurrent_requests = 2
+					}
+
+					resource "porkbun_dns_record" "test" {
+						count   = 10
+						domain  = "example.com"
+						name    = "r${count.index}"
+						type    = "A"
+						content = "1.2.3.4"
+					}
+				`, mockbunServer.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckMockDNSRecords(mockbunServer, "example.com", expected),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderZoneReadCache(t *testing.T) {
+	mockbunServer := mockbun.New()
+	t.Cleanup(mockbunServer.Close)
+
+	config := fmt.Sprintf(`
+		provider "porkbun" {
+			api_key         = "apikey"
+			secret_api_key  = "secretapikey"
+			custom_base_url = "%s"
+			zone_read_cache = true
+		}
+
+		resource "porkbun_dns_record" "test" {
+			count   = 10
+			domain  = "example.com"
+			name    = "r${count.index}"
+			type    = "A"
+			content = "1.2.3.4"
+		}
+
+		data "porkbun_dns_records" "test" {
+			domain = "example.com"
+		}
+	`, mockbunServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a refresh lists the zone once and answers every other read from the cache.
+			{
+				PreConfig: mockbunServer.ResetRequests,
+				Config:    config,
+				Check: func(_ *terraform.State) error {
+					if lists := mockbunServer.Requests("/dns/retrieve/{domain}"); lists != 1 {
+						return fmt.Errorf("expected refreshing to take 1 list request, got %d", lists)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestProviderValidateCredentials(t *testing.T) {
+	mockbunServer := mockbun.New()
+	t.Cleanup(mockbunServer.Close)
+	mockbunServer.SetNameservers("example.com", []string{"evan.ns.cloudflare.com"})
+
+	config := fmt.Sprintf(`
+		provider "porkbun" {
+			api_key              = "apikey"
+			secret_api_key       = "secretapikey"
+			custom_base_url      = "%s"
+			validate_credentials = true
+		}
+
+		data "porkbun_nameservers" "test" {
+			domain = "example.com"
+		}
+	`, mockbunServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: func(_ *terraform.State) error {
+					if pings := mockbunServer.Requests("/ping"); pings == 0 {
+						return fmt.Errorf("expected credentials to be validated with a ping")
+					}
+					return nil
+				},
+			},
+			// Test that invalid credentials fail when configuring the provider.
+			{
+				PreConfig:   func() { mockbunServer.SetFailure("Invalid API key. (002)") },
+				Config:      config,
+				ExpectError: regexp.MustCompile("Invalid Porkbun API key"),
+			},
+		},
+	})
+}
+
+func TestProviderDomains(t *testing.T) {
+	mockbunServer := mockbun.New()
+	t.Cleanup(mockbunServer.Close)
+	mockbunServer.SetNameservers("example.com", []string{})
+	mockbunServer.SetNameservers("example.org", []string{})
+
+	providerConfig := fmt.Sprintf(`
+		provider "porkbun" {
+			api_key         = "apikey"
+			secret_api_key  = "secretapikey"
+			custom_base_url = "%s"
+			domains         = ["example.com"]
+		}
+	`, mockbunServer.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					resource "porkbun_nameservers" "test" {
+						domain      = "example.com"
+						nameservers = ["evan.ns.cloudflare.com"]
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("porkbun_nameservers.test", "domain", "example.com"),
+			},
+			// Test that resources fail to plan for domains of other accounts.
+			{
+				Config: providerConfig + `
+					resource "porkbun_nameservers" "test" {
+						domain      = "example.org"
+						nameservers = ["evan.ns.cloudflare.com"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("Domain not allowed by provider configuration"),
+			},
+			// Test that data sources fail likewise.
+			{
+				Config: providerConfig + `
+					data "porkbun_nameservers" "test" {
+						domain = "example.org"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Domain not allowed by provider configuration"),
+			},
+		},
+	})
+}
+
+func TestProviderHTTPTransport(t *testing.T) {
+	mockbunServer := mockbun.New()
+	t.Cleanup(mockbunServer.Close)
+	mockbunServer.SetNameservers("example.com", []string{"evan.ns.cloudflare.com"})
+
+	providerConfig := func(extra string) string {
+		return fmt.Sprintf(`
+			provider "porkbun" {
+				api_key         = "apikey"
+				secret_api_key  = "secretapikey"
+				custom_base_url = "%s"
+				request_timeout = 5
+				retry_wait_min  = 0
+				retry_wait_max  = 1
+				%s
+			}
+
+			data "porkbun_nameservers" "test" {
+				domain = "example.com"
+			}
+		`, mockbunServer.URL, extra)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(""),
+				Check: func(_ *terraform.State) error {
+					userAgent := mockbunServer.UserAgent()
+					if !regexp.MustCompile(`^Terraform/\S+ .* terraform-provider-porkbun/test$`).MatchString(userAgent) {
+						return fmt.Errorf("unexpected User-Agent %q", userAgent)
+					}
+					return nil
+				},
+			},
+			{
+				Config:      providerConfig(`ca_cert_file = "does-not-exist.pem"`),
+				ExpectError: regexp.MustCompile("cannot load the CA certificates"),
+			},
+			{
+				Config:      providerConfig(`http_proxy = "not a url"`),
+				ExpectError: regexp.MustCompile(`"http_proxy" is not a valid URL`),
+			},
+		},
+	})
+}
+
+func TestProviderLookupEnv(t *testing.T) {
+	testCases := []struct {
+		env      string
+		expected int64
+		err      bool
+	}{
+		{env: "30", expected: 30},
+		{env: "30s", expected: 10, err: true},
+		{env: "0", expected: 10, err: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.env, func(t *testing.T) {
+			t.Setenv("PORKBUN_REQUEST_TIMEOUT", tc.env)
+
+			var diags diag.Diagnostics
+			requestTimeout := lookupEnvInt64("request_timeout", 10, 1, &diags)
+			if diags.HasError() != tc.err {
+				t.Fatalf("expected error %t, got %v", tc.err, diags)
+			}
+			if requestTimeout != tc.expected {
+				t.Errorf("expected %d, got %d", tc.expected, requestTimeout)
+			}
+		})
+	}
+
+	t.Run("unset", func(t *testing.T) {
+		// Setenv restores the variable once the test is done.
+		t.Setenv("PORKBUN_ZONE_READ_CACHE", "")
+		os.Unsetenv("PORKBUN_ZONE_READ_CACHE")
+
+		var diags diag.Diagnostics
+		if zoneReadCache := lookupEnvBool("zone_read_cache", true, &diags); !zoneReadCache || diags.HasError() {
+			t.Errorf("expected the default to be kept, got %t and %v", zoneReadCache, diags)
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+// retryPolicy retries everything retryablehttp retries by default, plus responses Porkbun uses to signal throttling
+// which don't necessarily come with a 429 or 5xx status code. Requests the client marks as unsafe to repeat are never
+// retried.
+func retryPolicy(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if !porkbun.RetriesAllowed(ctx) {
+		return false, nil
+	}
+
+	retry, checkErr := retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	if retry || checkErr != nil || resp == nil {
+		return retry, checkErr
+	}
+
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return false, nil
+	}
+
+	return porkbun.IsRateLimitResponse(resp.StatusCode, body), nil
+}
+
+// retryBackoff waits as long as the Retry-After header asks for, regardless of the status code, and otherwise backs
+// off exponentially.
+func retryBackoff(waitMin, waitMax time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+				return time.Duration(seconds) * time.Second
+			}
+			if date, err := http.ParseTime(retryAfter); err == nil {
+				return max(time.Until(date), 0)
+			}
+		}
+	}
+
+	return retryablehttp.DefaultBackoff(waitMin, waitMax, attemptNum, resp)
+}
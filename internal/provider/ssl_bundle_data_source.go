@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SSLBundleDataSource{}
+	_ datasource.DataSourceWithConfigure = &SSLBundleDataSource{}
+)
+
+type SSLBundleDataSource struct {
+	client *porkbun.Client
+}
+
+func NewSSLBundleDataSource() datasource.DataSource {
+	return &SSLBundleDataSource{}
+}
+
+func (d *SSLBundleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_bundle"
+}
+
+func (d *SSLBundleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the free SSL certificate bundle Porkbun generates for your domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the domain.",
+				Required:            true,
+			},
+			"certificate_chain": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded certificate chain, starting with the leaf certificate.",
+				Computed:            true,
+			},
+			"private_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded private key of the certificate.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded public key of the certificate.",
+				Computed:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the issuer of the leaf certificate.",
+				Computed:            true,
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "The time the leaf certificate becomes valid, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "The time the leaf certificate expires, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"sans": schema.ListAttribute{
+				MarkdownDescription: "The subject alternative names of the leaf certificate.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+type SSLBundleDataSourceModel struct {
+	Domain           types.String   `tfsdk:"domain"`
+	CertificateChain types.String   `tfsdk:"certificate_chain"`
+	PrivateKey       types.String   `tfsdk:"private_key"`
+	PublicKey        types.String   `tfsdk:"public_key"`
+	Issuer           types.String   `tfsdk:"issuer"`
+	NotBefore        types.String   `tfsdk:"not_before"`
+	NotAfter         types.String   `tfsdk:"not_after"`
+	SANs             []types.String `tfsdk:"sans"`
+}
+
+func (d *SSLBundleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *SSLBundleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state SSLBundleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	checkAllowedDomain(d.client, state.Domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundle, err := d.client.RetrieveSSLBundle(ctx, state.Domain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to retrieve SSL bundle", err)
+		return
+	}
+
+	// The first certificate of the chain is the leaf certificate.
+	block, _ := pem.Decode([]byte(bundle.CertificateChain))
+	if block == nil {
+		resp.Diagnostics.AddError("Unable to parse SSL certificate", "The certificate chain does not contain a PEM encoded certificate.")
+		return
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to parse SSL certificate", err.Error())
+		return
+	}
+
+	sans := make([]types.String, 0, len(certificate.DNSNames)+len(certificate.IPAddresses))
+	for _, name := range certificate.DNSNames {
+		sans = append(sans, types.StringValue(name))
+	}
+	for _, ip := range certificate.IPAddresses {
+		sans = append(sans, types.StringValue(ip.String()))
+	}
+
+	state.CertificateChain = types.StringValue(bundle.CertificateChain)
+	state.PrivateKey = types.StringValue(bundle.PrivateKey)
+	state.PublicKey = types.StringValue(bundle.PublicKey)
+	state.Issuer = types.StringValue(certificate.Issuer.String())
+	state.NotBefore = types.StringValue(certificate.NotBefore.UTC().Format(time.RFC3339))
+	state.NotAfter = types.StringValue(certificate.NotAfter.UTC().Format(time.RFC3339))
+	state.SANs = sans
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestSSLBundleDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetSSLBundle("example.com", generateSSLBundle(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "porkbun_ssl_bundle" "test" {
+						domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.porkbun_ssl_bundle.test", "certificate_chain"),
+					resource.TestCheckResourceAttrSet("data.porkbun_ssl_bundle.test", "private_key"),
+					resource.TestCheckResourceAttr("data.porkbun_ssl_bundle.test", "issuer", "CN=Mockbun Test CA"),
+					resource.TestCheckResourceAttr("data.porkbun_ssl_bundle.test", "not_after", "2030-01-02T03:04:05Z"),
+					resource.TestCheckResourceAttr("data.porkbun_ssl_bundle.test", "sans.#", "2"),
+					resource.TestCheckResourceAttr("data.porkbun_ssl_bundle.test", "sans.0", "example.com"),
+					resource.TestCheckResourceAttr("data.porkbun_ssl_bundle.test", "sans.1", "*.example.com"),
+				),
+			},
+		},
+	})
+}
+
+// generateSSLBundle creates a self-signed certificate for example.com that expires at notAfter.
+func generateSSLBundle(t *testing.T, notAfter time.Time) porkbun.SSLBundle {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Mockbun Test CA"},
+		DNSNames:     []string{"example.com", "*.example.com"},
+		NotBefore:    notAfter.AddDate(0, -3, 0),
+		NotAfter:     notAfter,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return porkbun.SSLBundle{
+		CertificateChain: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})),
+		PrivateKey:       string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})),
+		PublicKey:        string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+	}
+}
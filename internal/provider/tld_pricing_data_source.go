@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TLDPricingDataSource{}
+	_ datasource.DataSourceWithConfigure = &TLDPricingDataSource{}
+)
+
+type TLDPricingDataSource struct {
+	client *porkbun.Client
+}
+
+func NewTLDPricingDataSource() datasource.DataSource {
+	return &TLDPricingDataSource{}
+}
+
+func (d *TLDPricingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tld_pricing"
+}
+
+func (d *TLDPricingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get the default pricing of the TLDs Porkbun supports. All prices are in USD.",
+		Attributes: map[string]schema.Attribute{
+			"tlds": schema.SetAttribute{
+				MarkdownDescription: "Only return the pricing of these TLDs, e.g. `com`. Returns every supported TLD when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pricing": schema.MapNestedAttribute{
+				MarkdownDescription: "The pricing keyed by TLD.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"registration": schema.Float64Attribute{
+							MarkdownDescription: "The price to register a domain for a year.",
+							Computed:            true,
+						},
+						"renewal": schema.Float64Attribute{
+							MarkdownDescription: "The price to renew a domain for a year.",
+							Computed:            true,
+						},
+						"transfer": schema.Float64Attribute{
+							MarkdownDescription: "The price to transfer a domain.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type TLDPricingDataSourceModel struct {
+	TLDs    []types.String                            `tfsdk:"tlds"`
+	Pricing map[string]TLDPricingDataSourcePriceModel `tfsdk:"pricing"`
+}
+
+type TLDPricingDataSourcePriceModel struct {
+	Registration types.Float64 `tfsdk:"registration"`
+	Renewal      types.Float64 `tfsdk:"renewal"`
+	Transfer     types.Float64 `tfsdk:"transfer"`
+}
+
+func (d *TLDPricingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *TLDPricingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state TLDPricingDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pricing, err := d.client.GetPricing(ctx)
+	if err != nil {
+		addAPIError(&resp.Diagnostics, "Unable to get pricing", err)
+		return
+	}
+
+	tlds := make(map[string]bool, len(state.TLDs))
+	for _, tld := range state.TLDs {
+		tlds[tld.ValueString()] = true
+	}
+
+	tfPricing := make(map[string]TLDPricingDataSourcePriceModel)
+	for tld, price := range pricing {
+		if len(tlds) > 0 && !tlds[tld] {
+			continue
+		}
+
+		tfPricing[tld] = TLDPricingDataSourcePriceModel{
+			Registration: parsePrice(price.Registration),
+			Renewal:      parsePrice(price.Renewal),
+			Transfer:     parsePrice(price.Transfer),
+		}
+	}
+	state.Pricing = tfPricing
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
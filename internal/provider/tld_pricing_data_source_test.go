@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestTLDPricingDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetPricing(map[string]porkbun.TLDPricing{
+		"com": {Registration: "9.68", Renewal: "10.37", Transfer: "9.68"},
+		"dev": {Registration: "10.81", Renewal: "10.81", Transfer: "10.81"},
+		"xyz": {Registration: "2.04", Renewal: "12.98", Transfer: "12.98"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "porkbun_tld_pricing" "test" {
+						tlds = ["com", "dev"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_tld_pricing.test", "pricing.%", "2"),
+					resource.TestCheckResourceAttr("data.porkbun_tld_pricing.test", "pricing.com.registration", "9.68"),
+					resource.TestCheckResourceAttr("data.porkbun_tld_pricing.test", "pricing.com.renewal", "10.37"),
+					resource.TestCheckResourceAttr("data.porkbun_tld_pricing.test", "pricing.dev.transfer", "10.81"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// newTransport returns a transport sending requests through proxyURL, or else the proxy of the HTTPS_PROXY,
+// HTTP_PROXY and NO_PROXY environment variables, and trusting the certificates in caCertFile on top of the system's.
+func newTransport(proxyURL *url.URL, caCertFile string) (*http.Transport, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+	if proxyURL != nil {
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no PEM encoded certificates found")
+		}
+
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+
+	return transport, nil
+}
+
+// userAgent follows the format of the User-Agent HashiCorp's providers send.
+func userAgent(providerVersion, terraformVersion string) string {
+	return fmt.Sprintf("Terraform/%s (+https://www.terraform.io) terraform-provider-porkbun/%s", terraformVersion, providerVersion)
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &URLForwardResource{}
+	_ resource.ResourceWithImportState = &URLForwardResource{}
+	_ resource.ResourceWithModifyPlan  = &URLForwardResource{}
+)
+
+type URLForwardResource struct {
+	client *porkbun.Client
+}
+
+func NewURLForwardResource() resource.Resource {
+	return &URLForwardResource{}
+}
+
+func (r *URLForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_forward"
+}
+
+func (r *URLForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Porkbun has no API to edit URL forwards, so every change replaces the forward.
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Forward your domain or its subdomains to another URL.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the URL forward.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain to forward.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "The subdomain to forward, not including the domain itself. Leave blank to forward the root domain.",
+				Optional:            true,
+				Default:             stringdefault.StaticString(""),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "The URL to forward to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the forward. Valid types are `temporary` (HTTP 302) and `permanent` (HTTP 301). " +
+					"Defaults to `temporary`.",
+				Optional: true,
+				Default:  stringdefault.StaticString("temporary"),
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("temporary", "permanent"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_path": schema.BoolAttribute{
+				MarkdownDescription: "Whether the URI path is appended to the location when forwarding.",
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"wildcard": schema.BoolAttribute{
+				MarkdownDescription: "Whether all subdomains of the domain are forwarded as well.",
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+type URLForwardResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Domain      types.String `tfsdk:"domain"`
+	Subdomain   types.String `tfsdk:"subdomain"`
+	Location    types.String `tfsdk:"location"`
+	Type        types.String `tfsdk:"type"`
+	IncludePath types.Bool   `tfsdk:"include_path"`
+	Wildcard    types.Bool   `tfsdk:"wildcard"`
+}
+
+func (r *URLForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedResourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+}
+
+func (r *URLForwardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanCheckDomain(ctx, r.client, req, resp)
+}
+
+func (r *URLForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data URLForwardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	forward := porkbun.URLForward{
+		Subdomain:   data.Subdomain.ValueString(),
+		Location:    data.Location.ValueString(),
+		Type:        data.Type.ValueString(),
+		IncludePath: yesNo(data.IncludePath.ValueBool()),
+		Wildcard:    yesNo(data.Wildcard.ValueBool()),
+	}
+
+	// Porkbun doesn't return the ID of the created forward, so it's found by comparing forwards before and after.
+	existing, err := r.client.GetURLForwards(ctx, domain)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get URL forwards", err)
+		return
+	}
+
+	err = r.client.AddURLForward(ctx, domain, forward)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to add URL forward", err)
+		return
+	}
+
+	forwards, err := r.client.GetURLForwards(ctx, domain)
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get URL forwards", err)
+		return
+	}
+
+	var id string
+	for _, f := range forwards {
+		isNew := true
+		for _, e := range existing {
+			if e.ID == f.ID {
+				isNew = false
+				break
+			}
+		}
+
+		if isNew && f.Subdomain == forward.Subdomain && f.Location == forward.Location {
+			id = f.ID
+		}
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Unable to add URL forward",
+			"The URL forward was added but could not be found afterwards. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data URLForwardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwards, err := r.client.GetURLForwards(ctx, data.Domain.ValueString())
+	if errors.Is(err, porkbun.ErrNotFound) {
+		// The domain is no longer in the account.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get URL forwards", err)
+		return
+	}
+
+	var forward *porkbun.URLForward
+	for i := range forwards {
+		if forwards[i].ID == data.ID.ValueString() {
+			forward = &forwards[i]
+			break
+		}
+	}
+
+	if forward == nil {
+		// The URL forward has been deleted outside of Terraform, plan to recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Subdomain = types.StringValue(forward.Subdomain)
+	data.Location = types.StringValue(forward.Location)
+	data.Type = types.StringValue(forward.Type)
+	data.IncludePath = types.BoolValue(forward.IncludePath == "yes")
+	data.Wildcard = types.BoolValue(forward.Wildcard == "yes")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute requires replacement, so there's nothing to update in place.
+	var data URLForwardResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data URLForwardResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteURLForward(ctx, data.Domain.ValueString(), data.ID.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to delete URL forward", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *URLForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importId := strings.SplitN(req.ID, "/", 2)
+
+	var domain string
+	var id string
+	if len(importId) == 2 {
+		domain, id = importId[0], importId[1]
+	} else {
+		resp.Diagnostics.AddError(
+			"Invalid import ID specified",
+			"Use the import ID of format \"FQDN/forwardID\" to import URL forwards.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
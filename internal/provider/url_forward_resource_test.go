@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestURLForwardResource(t *testing.T) {
+	providerConfig, _ := getProviderConfigWithMockServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test create and read.
+			{
+				Config: providerConfig + `
+					resource "porkbun_url_forward" "test" {
+						domain       = "example.com"
+						subdomain    = "blog"
+						location     = "https://blog.example.net"
+						type         = "permanent"
+						include_path = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("porkbun_url_forward.test", "id"),
+					resource.TestCheckResourceAttr("porkbun_url_forward.test", "subdomain", "blog"),
+					resource.TestCheckResourceAttr("porkbun_url_forward.test", "location", "https://blog.example.net"),
+					resource.TestCheckResourceAttr("porkbun_url_forward.test", "type", "permanent"),
+					resource.TestCheckResourceAttr("porkbun_url_forward.test", "include_path", "true"),
+					resource.TestCheckResourceAttr("porkbun_url_forward.test", "wildcard", "false"),
+				),
+			},
+			// Test import.
+			{
+				ResourceName: "porkbun_url_forward.test",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return "example.com/" + s.RootModule().Resources["porkbun_url_forward.test"].Primary.ID, nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestURLForwardResourceDeletedOutOfBand(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	config := providerConfig + `
+		resource "porkbun_url_forward" "test" {
+			domain    = "example.com"
+			subdomain = "blog"
+			location  = "https://blog.example.net"
+			type      = "permanent"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// Test that a URL forward deleted outside of Terraform is planned to be re-created instead of failing.
+			{
+				PreConfig: func() {
+					mockbun.SetURLForwards("example.com", nil)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+	"github.com/kyswtn/terraform-provider-porkbun/internal/consts"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &URLForwardsDataSource{}
+	_ datasource.DataSourceWithConfigure = &URLForwardsDataSource{}
+)
+
+type URLForwardsDataSource struct {
+	client *porkbun.Client
+}
+
+func NewURLForwardsDataSource() datasource.DataSource {
+	return &URLForwardsDataSource{}
+}
+
+func (d *URLForwardsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_forwards"
+}
+
+func (d *URLForwardsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get URL forwards of your domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the domain.",
+				Required:            true,
+			},
+			"forwards": schema.ListNestedAttribute{
+				MarkdownDescription: "The URL forwards of the domain.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the URL forward.",
+							Computed:            true,
+						},
+						"subdomain": schema.StringAttribute{
+							MarkdownDescription: "The forwarded subdomain, empty for the root domain.",
+							Computed:            true,
+						},
+						"location": schema.StringAttribute{
+							MarkdownDescription: "The URL forwarded to.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the forward, either `temporary` or `permanent`.",
+							Computed:            true,
+						},
+						"include_path": schema.BoolAttribute{
+							MarkdownDescription: "Whether the URI path is appended to the location when forwarding.",
+							Computed:            true,
+						},
+						"wildcard": schema.BoolAttribute{
+							MarkdownDescription: "Whether all subdomains of the domain are forwarded as well.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type URLForwardsDataSourceModel struct {
+	Domain   types.String                        `tfsdk:"domain"`
+	Forwards []URLForwardsDataSourceForwardModel `tfsdk:"forwards"`
+}
+
+type URLForwardsDataSourceForwardModel struct {
+	ID          types.String `tfsdk:"id"`
+	Subdomain   types.String `tfsdk:"subdomain"`
+	Location    types.String `tfsdk:"location"`
+	Type        types.String `tfsdk:"type"`
+	IncludePath types.Bool   `tfsdk:"include_path"`
+	Wildcard    types.Bool   `tfsdk:"wildcard"`
+}
+
+func (d *URLForwardsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*PorkbunProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.ErrUnexpectedDataSourceConfigureType,
+			fmt.Sprintf("Expected *PorkbunProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+func (d *URLForwardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state URLForwardsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	checkAllowedDomain(d.client, state.Domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwards, err := d.client.GetURLForwards(ctx, state.Domain.ValueString())
+	if err != nil {
+		addDomainAPIError(&resp.Diagnostics, "Unable to get URL forwards", err)
+		return
+	}
+
+	tfForwards := make([]URLForwardsDataSourceForwardModel, len(forwards))
+	for i, forward := range forwards {
+		tfForwards[i] = URLForwardsDataSourceForwardModel{
+			ID:          types.StringValue(forward.ID),
+			Subdomain:   types.StringValue(forward.Subdomain),
+			Location:    types.StringValue(forward.Location),
+			Type:        types.StringValue(forward.Type),
+			IncludePath: types.BoolValue(forward.IncludePath == "yes"),
+			Wildcard:    types.BoolValue(forward.Wildcard == "yes"),
+		}
+	}
+	state.Forwards = tfForwards
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
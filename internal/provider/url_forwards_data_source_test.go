@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+func TestURLForwardsDataSource(t *testing.T) {
+	providerConfig, mockbun := getProviderConfigWithMockServer(t)
+	mockbun.SetURLForwards("example.com", []porkbun.URLForward{
+		{ID: "1", Subdomain: "", Location: "https://example.net", Type: "temporary", IncludePath: "no", Wildcard: "yes"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+					data "porkbun_url_forwards" "test" {
+						domain = "example.com"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.porkbun_url_forwards.test", "forwards.#", "1"),
+					resource.TestCheckResourceAttr("data.porkbun_url_forwards.test", "forwards.0.id", "1"),
+					resource.TestCheckResourceAttr("data.porkbun_url_forwards.test", "forwards.0.location", "https://example.net"),
+					resource.TestCheckResourceAttr("data.porkbun_url_forwards.test", "forwards.0.include_path", "false"),
+					resource.TestCheckResourceAttr("data.porkbun_url_forwards.test", "forwards.0.wildcard", "true"),
+				),
+			},
+		},
+	})
+}
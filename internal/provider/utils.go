@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	porkbun "github.com/kyswtn/terraform-provider-porkbun/internal/client"
+)
+
+// Porkbun returns record names as FQDNs, e.g. `www.example.com`. This strips the domain so that the name matches
+// what users configure, i.e. `www`, or an empty string for the root domain.
+func trimDomainFromName(name, domain string) string {
+	if name == domain {
+		return ""
+	}
+
+	return strings.ReplaceAll(name, fmt.Sprintf(".%s", domain), "")
+}
+
+// joinFQDN is the inverse of trimDomainFromName.
+func joinFQDN(name, domain string) string {
+	if name == "" {
+		return domain
+	}
+
+	return fmt.Sprintf("%s.%s", name, domain)
+}
+
+// sameFQDN reports whether two FQDNs are equal regardless of case and the trailing dot.
+func sameFQDN(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// splitFQDN splits an FQDN into the longest of the given domains it belongs to and the name within that domain.
+func splitFQDN(fqdn string, domains []string) (domain, name string, ok bool) {
+	fqdn = strings.ToLower(strings.TrimSuffix(fqdn, "."))
+
+	for _, candidate := range domains {
+		candidate = strings.ToLower(candidate)
+		if len(candidate) <= len(domain) {
+			continue
+		}
+
+		if fqdn == candidate {
+			domain, name, ok = candidate, "", true
+		} else if strings.HasSuffix(fqdn, "."+candidate) {
+			domain, name, ok = candidate, strings.TrimSuffix(fqdn, "."+candidate), true
+		}
+	}
+
+	return domain, name, ok
+}
+
+// normalizeDNSRecords strips the domain from record names and drops the priority of 0 Porkbun returns for record
+// types that don't support it, so that retrieved records can be compared to configured ones.
+func normalizeDNSRecords(records []porkbun.DNSRecord, domain string) []porkbun.DNSRecord {
+	for i := range records {
+		records[i].Name = trimDomainFromName(records[i].Name, domain)
+
+		if !dnsRecordUsesPriority(records[i].Type) {
+			records[i].Priority = ""
+		}
+	}
+
+	return records
+}
+
+func dnsRecordUsesPriority(recordType string) bool {
+	return recordType == "MX" || recordType == "SRV"
+}
+
+// normalizeDNSRecordContent returns content in canonical form: IP addresses as net.IP formats them, and hostnames in
+// lower case without the trailing dot. A lone "." is kept, it's the target of null MX and SRV records.
+func normalizeDNSRecordContent(recordType, content string) string {
+	hostname := func(value string) string {
+		if value == "." {
+			return value
+		}
+		return strings.ToLower(strings.TrimSuffix(value, "."))
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(content); ip != nil {
+			return ip.String()
+		}
+	case "CNAME", "ALIAS", "NS", "MX":
+		return hostname(content)
+	case "SRV":
+		if fields := strings.Fields(content); len(fields) == 3 {
+			return fmt.Sprintf("%s %s %s", fields[0], fields[1], hostname(fields[2]))
+		}
+	}
+
+	return content
+}
+
+// sameDNSRecordContent reports whether two contents of a record type are equal once normalized.
+func sameDNSRecordContent(recordType, a, b string) bool {
+	return normalizeDNSRecordContent(recordType, a) == normalizeDNSRecordContent(recordType, b)
+}
+
+type dnsRecordChanges struct {
+	create []porkbun.DNSRecord
+	// edit holds the desired records, each carrying the ID of the existing record it replaces.
+	edit   []porkbun.DNSRecord
+	delete []porkbun.DNSRecord
+}
+
+func (c *dnsRecordChanges) summary(domain string) string {
+	describe := func(record porkbun.DNSRecord) string {
+		name := domain
+		if record.Name != "" {
+			name = fmt.Sprintf("%s.%s", record.Name, domain)
+		}
+
+		return fmt.Sprintf("%s %s %s", name, record.Type, record.Content)
+	}
+
+	var lines []string
+	for _, record := range c.create {
+		lines = append(lines, "  + "+describe(record))
+	}
+	for _, record := range c.edit {
+		lines = append(lines, "  ~ "+describe(record))
+	}
+	for _, record := range c.delete {
+		lines = append(lines, "  - "+describe(record))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// diffDNSRecords computes the fewest API calls needed to turn the actual records into the desired ones. Desired records
+// are first matched to identical actual records, then to ones with the same name, type and content so that only
+// their TTL, priority or notes are edited, and lastly to ones with the same name and type so that their content is
+// edited in place instead of being deleted and recreated.
+func diffDNSRecords(desired, actual []porkbun.DNSRecord, preserve func(porkbun.DNSRecord) bool) dnsRecordChanges {
+	var changes dnsRecordChanges
+	matched := make([]bool, len(actual))
+
+	match := func(records []porkbun.DNSRecord, same func(a, b porkbun.DNSRecord) bool, edit bool) []porkbun.DNSRecord {
+		var unmatched []porkbun.DNSRecord
+		for _, record := range records {
+			found := false
+			for i, existing := range actual {
+				if !matched[i] && same(record, existing) {
+					matched[i] = true
+					found = true
+
+					if edit {
+						record.ID = existing.ID
+						changes.edit = append(changes.edit, record)
+					}
+					break
+				}
+			}
+
+			if !found {
+				unmatched = append(unmatched, record)
+			}
+		}
+
+		return unmatched
+	}
+
+	unmatched := match(desired, sameDNSRecord, false)
+	unmatched = match(unmatched, func(a, b porkbun.DNSRecord) bool {
+		return a.Name == b.Name && a.Type == b.Type && sameDNSRecordContent(a.Type, a.Content, b.Content)
+	}, true)
+	unmatched = match(unmatched, func(a, b porkbun.DNSRecord) bool {
+		return a.Name == b.Name && a.Type == b.Type
+	}, true)
+
+	changes.create = unmatched
+	for i, existing := range actual {
+		if !matched[i] && !preserve(existing) {
+			changes.delete = append(changes.delete, existing)
+		}
+	}
+
+	return changes
+}
+
+func applyDNSRecordChanges(ctx context.Context, client *porkbun.Client, domain string, changes dnsRecordChanges, diags *diag.Diagnostics) {
+	// Deletions go first so that conflicting records, e.g. a CNAME being replaced by an A record, are out of the way.
+	for _, record := range changes.delete {
+		err := client.DeleteDNSRecord(ctx, domain, record.ID)
+		if err != nil {
+			addDomainAPIError(diags, "Unable to delete DNS record", err)
+			return
+		}
+	}
+
+	for _, record := range changes.edit {
+		id := record.ID
+		record.ID = ""
+
+		err := client.EditDNSRecord(ctx, domain, id, record)
+		if err != nil {
+			addDomainAPIError(diags, "Unable to update DNS record", err)
+			return
+		}
+	}
+
+	for _, record := range changes.create {
+		_, err := client.CreateDNSRecord(ctx, domain, record)
+		if err != nil {
+			addDomainAPIError(diags, "Unable to create DNS record", err)
+			return
+		}
+	}
+}
+
+func sameDNSRecord(a, b porkbun.DNSRecord) bool {
+	return a.Name == b.Name && a.Type == b.Type && sameDNSRecordContent(a.Type, a.Content, b.Content) &&
+		a.TTL == b.TTL && a.Priority == b.Priority && a.Notes == b.Notes
+}
+
+// Porkbun uses "yes" and "no" strings for some boolean flags.
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
+
+// Porkbun returns prices as decimal strings, which are null when missing.
+func parsePrice(price string) types.Float64 {
+	parsed, err := strconv.ParseFloat(price, 64)
+	if err != nil {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(parsed)
+}
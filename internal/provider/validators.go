@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator validates that a string is an IP address, optionally of a specific version.
+type ipAddressValidator struct {
+	// version is either 4 or 6, or 0 to accept both.
+	version int
+}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	switch v.version {
+	case 4:
+		return "value must be a valid IPv4 address"
+	case 6:
+		return "value must be a valid IPv6 address"
+	default:
+		return "value must be a valid IPv4 or IPv6 address"
+	}
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value)
+
+	valid := ip != nil
+	switch v.version {
+	case 4:
+		valid = valid && ip.To4() != nil
+	case 6:
+		valid = valid && ip.To4() == nil
+	}
+
+	if !valid {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+func isIPAddress() validator.String {
+	return ipAddressValidator{}
+}
+
+var (
+	hostnameLabel = regexp.MustCompile(`^([A-Za-z0-9_]|[A-Za-z0-9_][A-Za-z0-9_-]{0,61}[A-Za-z0-9_])$`)
+	caaTag        = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+)
+
+// isHostname reports whether value is a hostname, with or without the trailing dot.
+func isHostname(value string) bool {
+	value = strings.TrimSuffix(value, ".")
+	if value == "" || len(value) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isUint(value string, limit uint64) bool {
+	n, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && n <= limit
+}
+
+// validateDNSRecordContent checks that content is well-formed for the record type, returning what's wrong with it or
+// an empty string if nothing is.
+func validateDNSRecordContent(recordType, content string) string {
+	switch recordType {
+	case "A":
+		if ip := net.ParseIP(content); ip == nil || ip.To4() == nil {
+			return "content of A records must be an IPv4 address"
+		}
+	case "AAAA":
+		if ip := net.ParseIP(content); ip == nil || ip.To4() != nil {
+			return "content of AAAA records must be an IPv6 address"
+		}
+	case "MX":
+		// A lone "." is a null MX record, saying the domain accepts no mail.
+		if content != "." && !isHostname(content) {
+			return "content of MX records must be a hostname, or \".\" for a null MX record"
+		}
+	case "CNAME", "ALIAS", "NS":
+		if !isHostname(content) {
+			return fmt.Sprintf("content of %s records must be a hostname", recordType)
+		}
+	case "SRV":
+		fields := strings.Fields(content)
+		if len(fields) != 3 || !isUint(fields[0], 65535) || !isUint(fields[1], 65535) ||
+			(fields[2] != "." && !isHostname(fields[2])) {
+			return `content of SRV records must be of format "weight port target", e.g. "5 5060 sip.example.com"`
+		}
+	case "CAA":
+		fields := strings.SplitN(content, " ", 3)
+		if len(fields) != 3 || !isUint(fields[0], 255) || !caaTag.MatchString(fields[1]) ||
+			len(fields[2]) < 2 || !strings.HasPrefix(fields[2], `"`) || !strings.HasSuffix(fields[2], `"`) {
+			return `content of CAA records must be of format "flags tag \"value\"", e.g. "0 issue \"letsencrypt.org\""`
+		}
+	case "TLSA":
+		fields := strings.Fields(content)
+		if len(fields) != 4 || !isUint(fields[0], 3) || !isUint(fields[1], 1) || !isUint(fields[2], 2) {
+			return `content of TLSA records must be of format "usage selector matching-type data", e.g. "3 1 1 0123abcd"`
+		}
+		if _, err := hex.DecodeString(fields[3]); err != nil {
+			return "certificate association data of TLSA records must be an even number of hexadecimal digits"
+		}
+	case "TXT":
+		return validateTXTContent(content)
+	}
+
+	return ""
+}
+
+// validateTXTContent accepts either unquoted text, or one or more quoted strings of at most 255 characters each as
+// DNS limits them to.
+func validateTXTContent(content string) string {
+	if content == "" {
+		return "content of TXT records must not be empty"
+	}
+
+	if !strings.HasPrefix(content, `"`) {
+		if strings.Contains(content, `"`) {
+			return "content of TXT records must either be quoted entirely or not contain quotes"
+		}
+		return ""
+	}
+
+	rest := content
+	for rest != "" {
+		if !strings.HasPrefix(rest, `"`) {
+			return `content of TXT records must be a space separated list of quoted strings, e.g. "\"part one\" \"part two\""`
+		}
+
+		// Find the closing quote, skipping escaped ones.
+		end := -1
+		length := 0
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == '\\' {
+				i++
+				length++
+				continue
+			}
+			if rest[i] == '"' {
+				end = i
+				break
+			}
+			length++
+		}
+
+		if end < 0 {
+			return "content of TXT records has an unterminated quoted string"
+		}
+		if length > 255 {
+			return "each quoted string in the content of TXT records must be at most 255 characters long"
+		}
+
+		rest = strings.TrimLeft(rest[end+1:], " ")
+	}
+
+	return ""
+}
+
+// validateDNSRecordPriority checks that priority is set for record types that need one, and only for those.
+func validateDNSRecordPriority(recordType string, priority types.Int64) string {
+	if priority.IsUnknown() {
+		return ""
+	}
+
+	switch recordType {
+	case "MX", "SRV":
+		if priority.IsNull() {
+			return fmt.Sprintf("priority is required for %s records", recordType)
+		}
+	default:
+		if !priority.IsNull() {
+			return fmt.Sprintf("priority is not supported for %s records", recordType)
+		}
+	}
+
+	return ""
+}